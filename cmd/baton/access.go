@@ -7,7 +7,9 @@ import (
 	"github.com/conductorone/baton-sdk/pkg/dotc1z/manager"
 	"github.com/conductorone/baton-sdk/pkg/logging"
 	v1 "github.com/conductorone/baton/pb/baton/v1"
+	"github.com/conductorone/baton/pkg/expansion"
 	"github.com/conductorone/baton/pkg/output"
+	"github.com/conductorone/baton/pkg/privileged"
 	"github.com/conductorone/baton/pkg/storecache"
 	"github.com/spf13/cobra"
 
@@ -23,11 +25,30 @@ func accessCmd() *cobra.Command {
 
 	addResourceTypeFlag(cmd)
 	addResourceFlag(cmd)
+	addExpandFlag(cmd)
+	addPrivilegedOnlyFlag(cmd)
 	cmd.MarkFlagsRequiredTogether(resourceTypeFlag, resourceFlag)
 
+	cmd.AddCommand(accessExplainCmd())
+
 	return cmd
 }
 
+func filterPrivileged(ctx context.Context, classifier *privileged.Classifier, sc *storecache.StoreCache, entitlements []*v2.Entitlement) ([]*v2.Entitlement, error) {
+	var ret []*v2.Entitlement
+	for _, en := range entitlements {
+		ok, err := classifier.IsPrivileged(ctx, sc, en)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			ret = append(ret, en)
+		}
+	}
+
+	return ret, nil
+}
+
 func runAccess(cmd *cobra.Command, args []string) error {
 	ctx, err := logging.Init(context.Background(), logging.WithLogFormat("console"), logging.WithLogLevel("error"))
 	if err != nil {
@@ -69,67 +90,124 @@ func runAccess(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("--%s and --%s are required", resourceTypeFlag, resourceFlag)
 	}
 
-	principal, err := sc.GetResource(ctx, &v2.ResourceId{
+	expand, err := cmd.Flags().GetBool(expandFlag)
+	if err != nil {
+		return err
+	}
+
+	classifier, err := getPrivilegedOnlyClassifier(cmd)
+	if err != nil {
+		return err
+	}
+
+	principalID := &v2.ResourceId{
 		ResourceType: resourceTypeID,
 		Resource:     resourceID,
-	})
+	}
+	principal, err := sc.GetResource(ctx, principalID)
 	if err != nil {
 		return err
 	}
 
 	var entitlements []*v2.Entitlement
-	pageToken := ""
-	for {
-		resp, err := store.ListGrants(ctx, &v2.GrantsServiceListGrantsRequest{
-			PageToken: pageToken,
-		})
+	var inherited []*v2.Entitlement
+	if expand {
+		graph, err := expansion.Load(ctx, store)
 		if err != nil {
 			return err
 		}
 
-		for _, g := range resp.List {
-			if g.Principal.Id.ResourceType == resourceTypeID && g.Principal.Id.Resource == resourceID {
-				en, err := sc.GetEntitlement(ctx, g.Entitlement.Id)
-				if err != nil {
-					return err
-				}
+		for _, a := range graph.EffectiveAccess(principalID) {
+			en, err := sc.GetEntitlement(ctx, a.EntitlementID)
+			if err != nil {
+				return err
+			}
+
+			if a.Direct {
 				entitlements = append(entitlements, en)
+			} else {
+				inherited = append(inherited, en)
 			}
 		}
+	} else {
+		pageToken := ""
+		for {
+			resp, err := store.ListGrants(ctx, &v2.GrantsServiceListGrantsRequest{
+				PageToken: pageToken,
+			})
+			if err != nil {
+				return err
+			}
 
-		if resp.NextPageToken == "" {
-			break
+			for _, g := range resp.List {
+				if g.Principal.Id.ResourceType == resourceTypeID && g.Principal.Id.Resource == resourceID {
+					en, err := sc.GetEntitlement(ctx, g.Entitlement.Id)
+					if err != nil {
+						return err
+					}
+					entitlements = append(entitlements, en)
+				}
+			}
+
+			if resp.NextPageToken == "" {
+				break
+			}
+			pageToken = resp.NextPageToken
 		}
-		pageToken = resp.NextPageToken
 	}
 
-	entitlementsByResource := make(map[string]*v1.ResourceAccessOutput)
+	if classifier != nil {
+		entitlements, err = filterPrivileged(ctx, classifier, sc, entitlements)
+		if err != nil {
+			return err
+		}
 
-	for _, en := range entitlements {
-		rKey := getResourceIdString(en.Resource)
+		inherited, err = filterPrivileged(ctx, classifier, sc, inherited)
+		if err != nil {
+			return err
+		}
+	}
 
-		var accessOutput *v1.ResourceAccessOutput
+	entitlementsByResource := make(map[string]*v1.ResourceAccessOutput)
+	getAccessOutput := func(en *v2.Entitlement) (*v1.ResourceAccessOutput, error) {
+		rKey := getResourceIdString(en.Resource)
 		if rAccess, ok := entitlementsByResource[rKey]; ok {
-			accessOutput = rAccess
-		} else {
-			resource, err := sc.GetResource(ctx, en.Resource.Id)
-			if err != nil {
-				return err
-			}
+			return rAccess, nil
+		}
 
-			rType, err := sc.GetResourceType(ctx, en.Resource.Id.ResourceType)
-			if err != nil {
-				return err
-			}
+		resource, err := sc.GetResource(ctx, en.Resource.Id)
+		if err != nil {
+			return nil, err
+		}
 
-			accessOutput = &v1.ResourceAccessOutput{
-				Resource:     resource,
-				ResourceType: rType,
-			}
+		rType, err := sc.GetResourceType(ctx, en.Resource.Id.ResourceType)
+		if err != nil {
+			return nil, err
 		}
 
-		accessOutput.Entitlements = append(accessOutput.Entitlements, en)
+		accessOutput := &v1.ResourceAccessOutput{
+			Resource:     resource,
+			ResourceType: rType,
+		}
 		entitlementsByResource[rKey] = accessOutput
+
+		return accessOutput, nil
+	}
+
+	for _, en := range entitlements {
+		accessOutput, err := getAccessOutput(en)
+		if err != nil {
+			return err
+		}
+		accessOutput.Entitlements = append(accessOutput.Entitlements, en)
+	}
+
+	for _, en := range inherited {
+		accessOutput, err := getAccessOutput(en)
+		if err != nil {
+			return err
+		}
+		accessOutput.InheritedEntitlements = append(accessOutput.InheritedEntitlements, en)
 	}
 
 	var outputs []*v1.ResourceAccessOutput
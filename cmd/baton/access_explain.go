@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/conductorone/baton-sdk/pkg/dotc1z/manager"
+	"github.com/conductorone/baton-sdk/pkg/logging"
+	v1 "github.com/conductorone/baton/pb/baton/v1"
+	"github.com/conductorone/baton/pkg/expansion"
+	"github.com/conductorone/baton/pkg/output"
+	"github.com/conductorone/baton/pkg/storecache"
+	"github.com/spf13/cobra"
+)
+
+func accessExplainCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "explain",
+		Short: "Explain every path through which a principal holds an entitlement",
+		RunE:  runAccessExplain,
+	}
+
+	addPrincipalFlag(cmd)
+	addEntitlementFlag(cmd)
+	addSyncIDFlag(cmd)
+	cmd.Flags().Int("max-paths", 100, "The maximum number of paths to output. Set to 0 to output every path.")
+
+	return cmd
+}
+
+func runAccessExplain(cmd *cobra.Command, args []string) error {
+	ctx, err := logging.Init(context.Background(), logging.WithLogFormat("console"), logging.WithLogLevel("error"))
+	if err != nil {
+		return err
+	}
+	c1zPath, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	outputFormat, err := cmd.Flags().GetString("output-format")
+	if err != nil {
+		return err
+	}
+	outputManager := output.NewManager(ctx, outputFormat)
+
+	syncID, err := cmd.Flags().GetString("sync-id")
+	if err != nil {
+		return err
+	}
+
+	principalID, err := getPrincipalFlag(cmd)
+	if err != nil {
+		return err
+	}
+
+	entitlementID, err := cmd.Flags().GetString(entitlementFlag)
+	if err != nil {
+		return err
+	}
+	if entitlementID == "" {
+		return fmt.Errorf("--%s is required", entitlementFlag)
+	}
+
+	maxPaths, err := cmd.Flags().GetInt("max-paths")
+	if err != nil {
+		return err
+	}
+
+	m, err := manager.New(ctx, c1zPath)
+	if err != nil {
+		return err
+	}
+	defer m.Close(ctx)
+
+	store, err := m.LoadC1Z(ctx)
+	if err != nil {
+		return err
+	}
+
+	if syncID != "" {
+		err = store.ViewSync(ctx, syncID)
+		if err != nil {
+			return err
+		}
+	}
+
+	sc := storecache.NewStoreCache(ctx, store)
+
+	graph, err := expansion.Load(ctx, store)
+	if err != nil {
+		return err
+	}
+
+	principal, err := sc.GetResource(ctx, principalID)
+	if err != nil {
+		return err
+	}
+
+	entitlement, err := sc.GetEntitlement(ctx, entitlementID)
+	if err != nil {
+		return err
+	}
+
+	var paths []*v1.AccessPath
+	for _, p := range graph.Paths(principalID, entitlementID, maxPaths) {
+		path := &v1.AccessPath{}
+		for _, hop := range p {
+			hopOutput, err := accessPathHopOutput(ctx, sc, hop)
+			if err != nil {
+				return err
+			}
+			path.Hops = append(path.Hops, hopOutput)
+		}
+		paths = append(paths, path)
+	}
+
+	err = outputManager.Output(ctx, &v1.AccessExplainOutput{
+		Principal:   principal,
+		Entitlement: entitlement,
+		Paths:       paths,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func accessPathHopOutput(ctx context.Context, sc *storecache.StoreCache, hop *expansion.Hop) (*v1.AccessPathHop, error) {
+	en, err := sc.GetEntitlement(ctx, hop.EntitlementID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &v1.AccessPathHop{
+		Entitlement: en,
+		Direct:      hop.Direct,
+	}
+
+	if en.Resource != nil && en.Resource.Id != nil {
+		ret.Resource, err = sc.GetResource(ctx, en.Resource.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		ret.ResourceType, err = sc.GetResourceType(ctx, en.Resource.Id.ResourceType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if hop.Edge != nil {
+		ret.Shallow = hop.Edge.Shallow
+		ret.Via, err = sc.GetResource(ctx, hop.Edge.Principal)
+		if err != nil {
+			return nil, err
+		}
+	} else if !hop.Direct {
+		ret.FromGrantSources = true
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,18 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func analyzeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "analyze",
+		Short: "Analyze access patterns in one or more C1Z files",
+	}
+
+	cmd.AddCommand(analyzeOutliersCmd())
+	cmd.AddCommand(analyzeRolesCmd())
+	cmd.AddCommand(analyzeGroupsCmd())
+
+	return cmd
+}
@@ -0,0 +1,331 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/conductorone/baton-sdk/pkg/logging"
+	v1 "github.com/conductorone/baton/pb/baton/v1"
+	"github.com/conductorone/baton/pkg/expansion"
+	"github.com/conductorone/baton/pkg/output"
+	"github.com/spf13/cobra"
+
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
+)
+
+func analyzeGroupsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "groups",
+		Short: "Analyze group nesting for depth, cycles, fan-out and empty groups",
+		RunE:  runAnalyzeGroups,
+	}
+
+	cmd.Flags().Float64("fan-out-ratio", 10, "Report groups whose effective membership is at least this many times their direct membership")
+	cmd.Flags().Uint32("fan-out-min", 50, "Only report fan-out for groups with at least this many effective members")
+	addSyncIDFlag(cmd)
+
+	return cmd
+}
+
+// groupMemberSlug is the slug connectors give a group's membership entitlement.
+const groupMemberSlug = "member"
+
+// groupNode is a group in the nesting graph. Children are the groups whose members are passed on the group's
+// membership by an expandable grant.
+type groupNode struct {
+	key         string
+	resource    *v2.Resource
+	memberships []string
+	members     map[string]struct{}
+	children    []*groupNode
+
+	// Set while finding strongly connected components.
+	index   int
+	lowlink int
+	onStack bool
+	scc     int
+}
+
+// groupSCCs assigns every group to a strongly connected component and returns the components. Components are
+// returned children first, so each component's children come before it.
+func groupSCCs(groups []*groupNode) [][]*groupNode {
+	for _, g := range groups {
+		g.index = -1
+	}
+
+	var ret [][]*groupNode
+	var stack []*groupNode
+	index := 0
+
+	var connect func(g *groupNode)
+	connect = func(g *groupNode) {
+		g.index = index
+		g.lowlink = index
+		index++
+		stack = append(stack, g)
+		g.onStack = true
+
+		for _, child := range g.children {
+			switch {
+			case child.index == -1:
+				connect(child)
+				g.lowlink = min(g.lowlink, child.lowlink)
+			case child.onStack:
+				g.lowlink = min(g.lowlink, child.index)
+			}
+		}
+
+		if g.lowlink != g.index {
+			return
+		}
+
+		var scc []*groupNode
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			top.onStack = false
+			top.scc = len(ret)
+			scc = append(scc, top)
+			if top == g {
+				break
+			}
+		}
+		ret = append(ret, scc)
+	}
+
+	for _, g := range groups {
+		if g.index == -1 {
+			connect(g)
+		}
+	}
+
+	return ret
+}
+
+// groupMemberships sets the membership entitlements of each group: its entitlement with the member slug, and any of
+// its entitlements that an expandable grant to the group passes on to other entitlements.
+func groupMemberships(ctx context.Context, s *c1zSource, graph *expansion.Graph, groupsByKey map[string]*groupNode) error {
+	add := func(g *groupNode, enID string) {
+		if !slices.Contains(g.memberships, enID) {
+			g.memberships = append(g.memberships, enID)
+		}
+	}
+
+	for _, g := range groupsByKey {
+		for _, enID := range graph.EntitlementsForResource(g.resource.Id) {
+			if en := graph.Entitlement(enID); en != nil && en.Slug == groupMemberSlug {
+				add(g, enID)
+			}
+		}
+	}
+
+	for _, e := range graph.Edges() {
+		g, ok := groupsByKey[expansion.ResourceKey(e.Principal)]
+		if !ok {
+			continue
+		}
+
+		en, err := s.sc.GetEntitlement(ctx, e.SourceEntitlementID)
+		if err != nil {
+			return err
+		}
+		if rID := en.GetResource().GetId(); rID != nil && expansion.ResourceKey(rID) == g.key {
+			add(g, e.SourceEntitlementID)
+		}
+	}
+
+	for _, g := range groupsByKey {
+		sort.Strings(g.memberships)
+	}
+
+	return nil
+}
+
+func runAnalyzeGroups(cmd *cobra.Command, args []string) error {
+	ctx, err := logging.Init(context.Background(), logging.WithLogFormat("console"), logging.WithLogLevel("error"))
+	if err != nil {
+		return err
+	}
+
+	c1zPath, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	outputFormat, err := cmd.Flags().GetString("output-format")
+	if err != nil {
+		return err
+	}
+	outputManager := output.NewManager(ctx, outputFormat)
+
+	syncID, err := cmd.Flags().GetString("sync-id")
+	if err != nil {
+		return err
+	}
+
+	fanOutRatio, err := cmd.Flags().GetFloat64("fan-out-ratio")
+	if err != nil {
+		return err
+	}
+
+	fanOutMin, err := cmd.Flags().GetUint32("fan-out-min")
+	if err != nil {
+		return err
+	}
+
+	s, err := openC1ZSource(ctx, c1zPath, syncID)
+	if err != nil {
+		return err
+	}
+	defer closeC1ZSources(ctx, []*c1zSource{s})
+
+	graph, err := s.Graph(ctx)
+	if err != nil {
+		return err
+	}
+
+	var groups []*groupNode
+	groupsByKey := make(map[string]*groupNode)
+	err = s.resourcesWithTrait(ctx, v2.ResourceType_TRAIT_GROUP, func(r *v2.Resource) error {
+		g := &groupNode{
+			key:      expansion.ResourceKey(r.Id),
+			resource: r,
+			members:  make(map[string]struct{}),
+		}
+		groups = append(groups, g)
+		groupsByKey[g.key] = g
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = groupMemberships(ctx, s, graph, groupsByKey)
+	if err != nil {
+		return err
+	}
+
+	groupsByMembership := make(map[string]*groupNode)
+	for _, g := range groups {
+		for _, enID := range g.memberships {
+			groupsByMembership[enID] = g
+			for _, grant := range graph.DirectGrantsForEntitlement(enID) {
+				g.members[expansion.ResourceKey(grant.Principal.Id)] = struct{}{}
+			}
+		}
+	}
+
+	// A group is nested in another when an expandable grant passes the other group's membership on to its members.
+	// Grants of other entitlements, such as a group administering another, are not nesting.
+	for _, e := range graph.Edges() {
+		child, ok := groupsByKey[expansion.ResourceKey(e.Principal)]
+		if !ok || !slices.Contains(child.memberships, e.SourceEntitlementID) {
+			continue
+		}
+
+		parent, ok := groupsByMembership[e.TargetEntitlementID]
+		if ok && parent != child && !slices.Contains(parent.children, child) {
+			parent.children = append(parent.children, child)
+		}
+	}
+
+	sccs := groupSCCs(groups)
+
+	// Components are visited children first, so a component's children already have their depth.
+	depths := make([]uint32, len(sccs))
+	cyclic := make([]bool, len(sccs))
+	for i, scc := range sccs {
+		cyclic[i] = len(scc) > 1
+		for _, g := range scc {
+			for _, child := range g.children {
+				if child.scc == i {
+					cyclic[i] = true
+					continue
+				}
+				depths[i] = max(depths[i], depths[child.scc]+1)
+			}
+		}
+	}
+
+	report := &v1.GroupsAnalysisOutput{
+		File:   c1zPath,
+		Groups: uint32(len(groups)),
+	}
+	for _, g := range groups {
+		resourceType, err := s.sc.GetResourceType(ctx, g.resource.Id.ResourceType)
+		if err != nil {
+			return err
+		}
+
+		// Effective members are taken from the expansion graph, so shallow and resource type limited grants are
+		// honored.
+		effective := make(map[string]struct{})
+		for _, enID := range g.memberships {
+			for _, h := range graph.EffectiveHolders(enID) {
+				key := expansion.ResourceKey(h.Principal)
+				if _, ok := groupsByKey[key]; !ok {
+					effective[key] = struct{}{}
+				}
+			}
+		}
+
+		out := &v1.GroupAnalysisOutput{
+			Group:            g.resource,
+			ResourceType:     resourceType,
+			DirectMembers:    uint32(len(g.members)),
+			DirectGroups:     uint32(len(g.children)),
+			EffectiveMembers: uint32(len(effective)),
+			NestingDepth:     depths[g.scc],
+			InCycle:          cyclic[g.scc],
+		}
+		report.MaxNestingDepth = max(report.MaxNestingDepth, out.NestingDepth)
+
+		if out.DirectGroups > 0 {
+			report.Nested = append(report.Nested, out)
+		}
+		if out.DirectMembers == 0 {
+			report.Empty = append(report.Empty, out)
+		}
+		if out.EffectiveMembers >= fanOutMin && float64(out.EffectiveMembers) >= fanOutRatio*float64(max(out.DirectMembers, 1)) {
+			report.FanOut = append(report.FanOut, out)
+		}
+	}
+
+	for i, scc := range sccs {
+		if !cyclic[i] {
+			continue
+		}
+
+		cycle := &v1.GroupCycleOutput{}
+		for _, g := range scc {
+			cycle.Groups = append(cycle.Groups, g.resource)
+		}
+		sort.Slice(cycle.Groups, func(i, j int) bool {
+			return cycle.Groups[i].DisplayName < cycle.Groups[j].DisplayName
+		})
+		report.Cycles = append(report.Cycles, cycle)
+	}
+
+	sort.SliceStable(report.Nested, func(i, j int) bool {
+		if report.Nested[i].NestingDepth != report.Nested[j].NestingDepth {
+			return report.Nested[i].NestingDepth > report.Nested[j].NestingDepth
+		}
+		return report.Nested[i].EffectiveMembers > report.Nested[j].EffectiveMembers
+	})
+	sort.SliceStable(report.FanOut, func(i, j int) bool {
+		ri := float64(report.FanOut[i].EffectiveMembers) / float64(max(report.FanOut[i].DirectMembers, 1))
+		rj := float64(report.FanOut[j].EffectiveMembers) / float64(max(report.FanOut[j].DirectMembers, 1))
+		return ri > rj
+	})
+	sort.SliceStable(report.Empty, func(i, j int) bool {
+		return report.Empty[i].Group.DisplayName < report.Empty[j].Group.DisplayName
+	})
+
+	err = outputManager.Output(ctx, report)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,306 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/conductorone/baton-sdk/pkg/logging"
+	v1 "github.com/conductorone/baton/pb/baton/v1"
+	"github.com/conductorone/baton/pkg/expansion"
+	"github.com/conductorone/baton/pkg/identity"
+	"github.com/conductorone/baton/pkg/output"
+	"github.com/spf13/cobra"
+
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
+)
+
+// groupByGroup makes every group a peer group of its effective members.
+const groupByGroup = "group"
+
+func analyzeOutliersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outliers [c1z files...]",
+		Short: "Find users whose access differs sharply from their peers",
+		RunE:  runAnalyzeOutliers,
+	}
+
+	cmd.Flags().String("group-by", "department", fmt.Sprintf("The user profile field to group peers by, or %q to group peers by shared group membership", groupByGroup))
+	cmd.Flags().Float64("threshold", 10, "Report entitlements held by fewer than this percentage of a user's peers")
+	cmd.Flags().Float64("baseline", 90, "Report users missing entitlements held by at least this percentage of their peers. Set to 0 to disable.")
+	cmd.Flags().Uint32("min-peers", 3, "Ignore peer groups with fewer members than this")
+	cmd.Flags().Bool("include-disabled", false, "Include users that are disabled or deleted")
+	addSyncIDFlag(cmd)
+
+	return cmd
+}
+
+type peerGroup struct {
+	name    string
+	members []*identity.Account
+}
+
+// profilePeerGroups groups users by the value of a profile field. Users without the field are left out.
+func profilePeerGroups(users []*identity.Account, field string) []*peerGroup {
+	byValue := make(map[string]*peerGroup)
+	var ret []*peerGroup
+	for _, a := range users {
+		value := a.ProfileValue(field)
+		if value == "" {
+			continue
+		}
+
+		g, ok := byValue[value]
+		if !ok {
+			g = &peerGroup{name: fmt.Sprintf("%s=%s", field, value)}
+			byValue[value] = g
+			ret = append(ret, g)
+		}
+		g.members = append(g.members, a)
+	}
+
+	return ret
+}
+
+// membershipPeerGroups makes a peer group of the users holding any entitlement on each group, directly or inherited.
+func membershipPeerGroups(ctx context.Context, s *c1zSource, users []*identity.Account) ([]*peerGroup, error) {
+	graph, err := s.Graph(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	usersByKey := make(map[string]*identity.Account)
+	for _, a := range users {
+		usersByKey[expansion.ResourceKey(a.Resource.Id)] = a
+	}
+
+	var ret []*peerGroup
+	err = s.resourcesWithTrait(ctx, v2.ResourceType_TRAIT_GROUP, func(r *v2.Resource) error {
+		g := &peerGroup{name: fmt.Sprintf("%s (%s)", r.DisplayName, expansion.ResourceKey(r.Id))}
+		seen := make(map[string]struct{})
+		for _, enID := range graph.EntitlementsForResource(r.Id) {
+			for _, h := range graph.EffectiveHolders(enID) {
+				key := expansion.ResourceKey(h.Principal)
+				a, ok := usersByKey[key]
+				if !ok {
+					continue
+				}
+				if _, ok := seen[key]; ok {
+					continue
+				}
+				seen[key] = struct{}{}
+				g.members = append(g.members, a)
+			}
+		}
+		ret = append(ret, g)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func runAnalyzeOutliers(cmd *cobra.Command, args []string) error {
+	ctx, err := logging.Init(context.Background(), logging.WithLogFormat("console"), logging.WithLogLevel("error"))
+	if err != nil {
+		return err
+	}
+
+	c1zPaths, err := getC1ZPaths(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	outputFormat, err := cmd.Flags().GetString("output-format")
+	if err != nil {
+		return err
+	}
+	outputManager := output.NewManager(ctx, outputFormat)
+
+	syncID, err := cmd.Flags().GetString("sync-id")
+	if err != nil {
+		return err
+	}
+
+	groupBy, err := cmd.Flags().GetString("group-by")
+	if err != nil {
+		return err
+	}
+	if groupBy == "" {
+		return fmt.Errorf("--group-by is required")
+	}
+
+	threshold, err := cmd.Flags().GetFloat64("threshold")
+	if err != nil {
+		return err
+	}
+
+	baseline, err := cmd.Flags().GetFloat64("baseline")
+	if err != nil {
+		return err
+	}
+
+	minPeers, err := cmd.Flags().GetUint32("min-peers")
+	if err != nil {
+		return err
+	}
+
+	includeDisabled, err := cmd.Flags().GetBool("include-disabled")
+	if err != nil {
+		return err
+	}
+
+	sources, err := openC1ZSources(ctx, c1zPaths, syncID)
+	defer closeC1ZSources(ctx, sources)
+	if err != nil {
+		return err
+	}
+
+	report := &v1.OutliersOutput{
+		Files:     c1zPaths,
+		GroupBy:   groupBy,
+		Threshold: threshold,
+		Baseline:  baseline,
+	}
+	for _, s := range sources {
+		graph, err := s.Graph(ctx)
+		if err != nil {
+			return err
+		}
+
+		accounts, err := s.userAccounts(ctx)
+		if err != nil {
+			return err
+		}
+
+		var users []*identity.Account
+		for _, a := range accounts {
+			if a.User == nil || (!includeDisabled && isUserInactive(a.User)) {
+				continue
+			}
+			users = append(users, a)
+		}
+
+		var groups []*peerGroup
+		if groupBy == groupByGroup {
+			groups, err = membershipPeerGroups(ctx, s, users)
+			if err != nil {
+				return err
+			}
+		} else {
+			groups = profilePeerGroups(users, groupBy)
+		}
+
+		access := make(map[*identity.Account]map[string]struct{})
+		userAccess := func(a *identity.Account) map[string]struct{} {
+			if held, ok := access[a]; ok {
+				return held
+			}
+			held := make(map[string]struct{})
+			for _, ac := range graph.EffectiveAccess(a.Resource.Id) {
+				held[ac.EntitlementID] = struct{}{}
+			}
+			access[a] = held
+			return held
+		}
+
+		for _, g := range groups {
+			if len(g.members) < int(minPeers) {
+				continue
+			}
+			report.PeerGroups++
+
+			holders := make(map[string]uint32)
+			for _, a := range g.members {
+				for enID := range userAccess(a) {
+					holders[enID]++
+				}
+			}
+
+			size := uint32(len(g.members))
+			outlier := func(a *identity.Account, enID string) (*v1.OutlierOutput, error) {
+				en, err := s.sc.GetEntitlement(ctx, enID)
+				if err != nil {
+					return nil, err
+				}
+
+				resourceType, err := s.sc.GetResourceType(ctx, a.Resource.Id.ResourceType)
+				if err != nil {
+					return nil, err
+				}
+
+				return &v1.OutlierOutput{
+					File:           s.path,
+					PeerGroup:      g.name,
+					PeerGroupSize:  size,
+					User:           a.Resource,
+					ResourceType:   resourceType,
+					Entitlement:    en,
+					Holders:        holders[enID],
+					HoldersPercent: float64(holders[enID]) * 100 / float64(size),
+				}, nil
+			}
+
+			for _, a := range g.members {
+				held := userAccess(a)
+				for enID := range held {
+					if float64(holders[enID])*100/float64(size) >= threshold {
+						continue
+					}
+
+					o, err := outlier(a, enID)
+					if err != nil {
+						return err
+					}
+					report.Rare = append(report.Rare, o)
+				}
+
+				if baseline <= 0 {
+					continue
+				}
+				for enID, count := range holders {
+					if _, ok := held[enID]; ok || float64(count)*100/float64(size) < baseline {
+						continue
+					}
+
+					o, err := outlier(a, enID)
+					if err != nil {
+						return err
+					}
+					report.MissingBaseline = append(report.MissingBaseline, o)
+				}
+			}
+		}
+	}
+
+	sortOutliers := func(outliers []*v1.OutlierOutput, rarestFirst bool) {
+		sort.SliceStable(outliers, func(i, j int) bool {
+			a, b := outliers[i], outliers[j]
+			if a.HoldersPercent != b.HoldersPercent {
+				return (a.HoldersPercent < b.HoldersPercent) == rarestFirst
+			}
+			if a.PeerGroupSize != b.PeerGroupSize {
+				return a.PeerGroupSize > b.PeerGroupSize
+			}
+			if a.PeerGroup != b.PeerGroup {
+				return a.PeerGroup < b.PeerGroup
+			}
+			if a.User.DisplayName != b.User.DisplayName {
+				return a.User.DisplayName < b.User.DisplayName
+			}
+			return a.Entitlement.Id < b.Entitlement.Id
+		})
+	}
+	sortOutliers(report.Rare, true)
+	sortOutliers(report.MissingBaseline, false)
+
+	err = outputManager.Output(ctx, report)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
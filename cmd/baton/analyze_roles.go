@@ -0,0 +1,215 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/conductorone/baton-sdk/pkg/logging"
+	v1 "github.com/conductorone/baton/pb/baton/v1"
+	"github.com/conductorone/baton/pkg/identity"
+	"github.com/conductorone/baton/pkg/output"
+	"github.com/conductorone/baton/pkg/rolemining"
+	"github.com/spf13/cobra"
+)
+
+func analyzeRolesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "roles",
+		Short: "Suggest candidate roles from sets of entitlements commonly held together",
+		RunE:  runAnalyzeRoles,
+	}
+
+	cmd.Flags().StringSliceP(resourceTypeFlag, "t", nil, "Only consider entitlements on resources of these resource types")
+	cmd.Flags().Float64("min-support", 10, "The minimum percentage of users that must hold every entitlement in a candidate role")
+	cmd.Flags().Uint32("min-size", 2, "The minimum number of entitlements in a candidate role")
+	cmd.Flags().Uint32("max-size", 10, "The number of entitlements after which candidate roles are no longer extended. Set to 0 for no limit.")
+	cmd.Flags().Uint32("max-roles", 20, "The maximum number of candidate roles to output")
+	cmd.Flags().Bool("include-disabled", false, "Include users that are disabled or deleted")
+	addExpandFlag(cmd)
+	addSyncIDFlag(cmd)
+
+	return cmd
+}
+
+// userEntitlementSets returns the IDs of the in-scope entitlements held by each user.
+func userEntitlementSets(
+	ctx context.Context,
+	s *c1zSource,
+	users []*identity.Account,
+	resourceTypes []string,
+	expand bool,
+) ([][]string, error) {
+	graph, err := s.Graph(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	inScope := func(enID string) (bool, error) {
+		if len(resourceTypes) == 0 {
+			return true, nil
+		}
+
+		en, err := s.sc.GetEntitlement(ctx, enID)
+		if err != nil {
+			return false, err
+		}
+		return slices.Contains(resourceTypes, en.GetResource().GetId().GetResourceType()), nil
+	}
+
+	ret := make([][]string, len(users))
+	for i, a := range users {
+		var held []string
+		if expand {
+			for _, access := range graph.EffectiveAccess(a.Resource.Id) {
+				held = append(held, access.EntitlementID)
+			}
+		} else {
+			for _, g := range graph.DirectGrants(a.Resource.Id) {
+				held = append(held, g.Entitlement.Id)
+			}
+		}
+
+		for _, enID := range held {
+			ok, err := inScope(enID)
+			if err != nil {
+				return nil, err
+			}
+			if ok && !slices.Contains(ret[i], enID) {
+				ret[i] = append(ret[i], enID)
+			}
+		}
+		sort.Strings(ret[i])
+	}
+
+	return ret, nil
+}
+
+func runAnalyzeRoles(cmd *cobra.Command, args []string) error {
+	ctx, err := logging.Init(context.Background(), logging.WithLogFormat("console"), logging.WithLogLevel("error"))
+	if err != nil {
+		return err
+	}
+
+	c1zPath, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	outputFormat, err := cmd.Flags().GetString("output-format")
+	if err != nil {
+		return err
+	}
+	outputManager := output.NewManager(ctx, outputFormat)
+
+	syncID, err := cmd.Flags().GetString("sync-id")
+	if err != nil {
+		return err
+	}
+
+	resourceTypes, err := cmd.Flags().GetStringSlice(resourceTypeFlag)
+	if err != nil {
+		return err
+	}
+
+	minSupport, err := cmd.Flags().GetFloat64("min-support")
+	if err != nil {
+		return err
+	}
+
+	minSize, err := cmd.Flags().GetUint32("min-size")
+	if err != nil {
+		return err
+	}
+
+	maxSize, err := cmd.Flags().GetUint32("max-size")
+	if err != nil {
+		return err
+	}
+
+	maxRoles, err := cmd.Flags().GetUint32("max-roles")
+	if err != nil {
+		return err
+	}
+
+	includeDisabled, err := cmd.Flags().GetBool("include-disabled")
+	if err != nil {
+		return err
+	}
+
+	expand, err := cmd.Flags().GetBool(expandFlag)
+	if err != nil {
+		return err
+	}
+
+	s, err := openC1ZSource(ctx, c1zPath, syncID)
+	if err != nil {
+		return err
+	}
+	defer closeC1ZSources(ctx, []*c1zSource{s})
+
+	accounts, err := s.userAccounts(ctx)
+	if err != nil {
+		return err
+	}
+
+	var users []*identity.Account
+	for _, a := range accounts {
+		if a.User == nil || (!includeDisabled && isUserInactive(a.User)) {
+			continue
+		}
+		users = append(users, a)
+	}
+
+	sets, err := userEntitlementSets(ctx, s, users, resourceTypes, expand)
+	if err != nil {
+		return err
+	}
+
+	itemsets := rolemining.ClosedItemsets(sets, rolemining.MinSupport(minSupport, len(users)), int(minSize), int(maxSize))
+	if maxRoles > 0 && len(itemsets) > int(maxRoles) {
+		itemsets = itemsets[:maxRoles]
+	}
+
+	report := &v1.RoleMiningOutput{
+		File:          c1zPath,
+		ResourceTypes: resourceTypes,
+		MinSupport:    minSupport,
+		Users:         uint32(len(users)),
+	}
+	for _, is := range itemsets {
+		candidate := &v1.RoleCandidateOutput{
+			UsersCovered:    uint32(is.Support()),
+			CoveragePercent: float64(is.Support()) * 100 / float64(len(users)),
+		}
+
+		for _, enID := range is.Items {
+			en, err := s.sc.GetEntitlement(ctx, enID)
+			if err != nil {
+				return err
+			}
+			candidate.Entitlements = append(candidate.Entitlements, en)
+		}
+
+		for _, t := range is.Transactions {
+			u := &v1.RoleCandidateUserOutput{User: users[t].Resource}
+			for _, enID := range is.Residual(sets[t]) {
+				en, err := s.sc.GetEntitlement(ctx, enID)
+				if err != nil {
+					return err
+				}
+				u.Exceptions = append(u.Exceptions, en)
+			}
+			candidate.Users = append(candidate.Users, u)
+		}
+
+		report.Candidates = append(report.Candidates, candidate)
+	}
+
+	err = outputManager.Output(ctx, report)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -5,11 +5,12 @@ import (
 	"encoding/csv"
 	"fmt"
 	"os"
+	"strings"
 
-	"github.com/conductorone/baton-sdk/pkg/annotations"
 	"github.com/conductorone/baton-sdk/pkg/connectorstore"
 	"github.com/conductorone/baton-sdk/pkg/dotc1z/manager"
 	"github.com/conductorone/baton-sdk/pkg/logging"
+	"github.com/conductorone/baton/pkg/expansion"
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 	"github.com/spf13/cobra"
 
@@ -24,6 +25,7 @@ func exportCSV() *cobra.Command {
 	}
 
 	cmd.Flags().String("out", "./sync.csv", "The path to export the CSV to")
+	addExportFlags(cmd)
 
 	return cmd
 }
@@ -97,6 +99,11 @@ func fetchEntitlements(ctx context.Context, store connectorstore.Reader) (map[st
 		}
 
 		for _, e := range resp.List {
+			ret[e.Id] = e
+			if e.GetResource().GetId() == nil {
+				continue
+			}
+
 			m, ok := retRt[fmtResourceID(e.Resource.Id)]
 			if !ok {
 				m = make(map[string]*v2.Entitlement)
@@ -104,7 +111,6 @@ func fetchEntitlements(ctx context.Context, store connectorstore.Reader) (map[st
 
 			m[e.Id] = e
 			retRt[fmtResourceID(e.Resource.Id)] = m
-			ret[e.Id] = e
 		}
 
 		if resp.NextPageToken == "" {
@@ -129,6 +135,10 @@ func fetchGrants(ctx context.Context, store connectorstore.Reader) (map[string]*
 		}
 
 		for _, g := range resp.List {
+			if g.GetEntitlement().GetId() == "" || g.GetPrincipal().GetId() == nil {
+				continue
+			}
+
 			m, ok := retRt[fmtResourceID(g.Principal.Id)]
 			if !ok {
 				m = make(map[string]*v2.Grant)
@@ -148,47 +158,127 @@ func fetchGrants(ctx context.Context, store connectorstore.Reader) (map[string]*
 	return ret, retRt, nil
 }
 
-func runExportCSV(cmd *cobra.Command, args []string) error {
-	ctx, err := logging.Init(context.Background(), logging.WithLogFormat("console"), logging.WithLogLevel("error"))
+// exportFilter limits an export to resources of a type, a single resource or a single entitlement, along with the
+// grants on them and the principals of those grants.
+type exportFilter struct {
+	resourceType  string
+	resourceID    string
+	entitlementID string
+}
+
+func addExportFlags(cmd *cobra.Command) {
+	addSyncIDFlag(cmd)
+	addResourceTypeFlag(cmd)
+	addResourceFlag(cmd)
+	addEntitlementFlag(cmd)
+	cmd.Flags().Bool(expandFlag, false, "Also export the access users inherit through grant expansion (nested groups, roles, etc.)")
+}
+
+func getExportFilter(cmd *cobra.Command) (exportFilter, error) {
+	resourceType, err := cmd.Flags().GetString(resourceTypeFlag)
 	if err != nil {
-		return err
+		return exportFilter{}, err
+	}
+
+	resourceID, err := cmd.Flags().GetString(resourceFlag)
+	if err != nil {
+		return exportFilter{}, err
+	}
+	if resourceID != "" && resourceType == "" {
+		return exportFilter{}, fmt.Errorf("--%s is required when --%s is set", resourceTypeFlag, resourceFlag)
 	}
+
+	entitlementID, err := cmd.Flags().GetString(entitlementFlag)
+	if err != nil {
+		return exportFilter{}, err
+	}
+
+	return exportFilter{
+		resourceType:  resourceType,
+		resourceID:    resourceID,
+		entitlementID: entitlementID,
+	}, nil
+}
+
+func (f exportFilter) empty() bool {
+	return f.resourceType == "" && f.resourceID == "" && f.entitlementID == ""
+}
+
+func (f exportFilter) matchesResource(id *v2.ResourceId) bool {
+	if f.resourceType != "" && id.GetResourceType() != f.resourceType {
+		return false
+	}
+	if f.resourceID != "" && id.GetResource() != f.resourceID {
+		return false
+	}
+
+	return true
+}
+
+func (f exportFilter) matchesEntitlement(e *v2.Entitlement) bool {
+	if f.entitlementID != "" && e.Id != f.entitlementID {
+		return false
+	}
+
+	return f.matchesResource(e.GetResource().GetId())
+}
+
+// loadExportData reads every object in the c1z file, from the sync selected by --sync-id, and applies the export
+// filters. With --expand, the access users inherit through grant expansion is added as well.
+func loadExportData(ctx context.Context, cmd *cobra.Command) (dataBag, error) {
 	c1zPath, err := cmd.Flags().GetString("file")
 	if err != nil {
-		return err
+		return dataBag{}, err
 	}
 
-	outPath, err := cmd.Flags().GetString("out")
+	syncID, err := cmd.Flags().GetString("sync-id")
 	if err != nil {
-		return err
+		return dataBag{}, err
+	}
+
+	filter, err := getExportFilter(cmd)
+	if err != nil {
+		return dataBag{}, err
+	}
+
+	expand, err := cmd.Flags().GetBool(expandFlag)
+	if err != nil {
+		return dataBag{}, err
 	}
 
 	m, err := manager.New(ctx, c1zPath)
 	if err != nil {
-		return err
+		return dataBag{}, err
 	}
 	defer m.Close(ctx)
 
 	store, err := m.LoadC1Z(ctx)
 	if err != nil {
-		return err
+		return dataBag{}, err
+	}
+
+	if syncID != "" {
+		err = store.ViewSync(ctx, syncID)
+		if err != nil {
+			return dataBag{}, err
+		}
 	}
 
 	resourceTypes, err := fetchResourceTypes(ctx, store)
 	if err != nil {
-		return err
+		return dataBag{}, err
 	}
 	resourcesByID, resourcesByType, err := fetchResources(ctx, store)
 	if err != nil {
-		return err
+		return dataBag{}, err
 	}
 	entitlementsByID, entitlementsByResource, err := fetchEntitlements(ctx, store)
 	if err != nil {
-		return err
+		return dataBag{}, err
 	}
 	grantsByID, grantsByPrincipal, err := fetchGrants(ctx, store)
 	if err != nil {
-		return err
+		return dataBag{}, err
 	}
 
 	d := dataBag{
@@ -201,6 +291,38 @@ func runExportCSV(cmd *cobra.Command, args []string) error {
 		grantsByType:       grantsByPrincipal,
 	}
 
+	ret := d.filter(filter)
+	if expand {
+		graph, err := expansion.Load(ctx, store)
+		if err != nil {
+			return dataBag{}, err
+		}
+
+		err = ret.expandGrants(graph, d)
+		if err != nil {
+			return dataBag{}, err
+		}
+	}
+
+	return ret, nil
+}
+
+func runExportCSV(cmd *cobra.Command, args []string) error {
+	ctx, err := logging.Init(context.Background(), logging.WithLogFormat("console"), logging.WithLogLevel("error"))
+	if err != nil {
+		return err
+	}
+
+	outPath, err := cmd.Flags().GetString("out")
+	if err != nil {
+		return err
+	}
+
+	d, err := loadExportData(ctx, cmd)
+	if err != nil {
+		return err
+	}
+
 	err = buildCSV(ctx, d, outPath)
 	if err != nil {
 		return err
@@ -222,6 +344,11 @@ type csvRow struct {
 	resourceName           string
 	entitlementDescription string
 	entitlementSlug        string
+	principalType          string
+	principalID            string
+	principalName          string
+	principalDetails       string
+	inheritedFrom          string
 }
 
 func (c csvRow) Row() []string {
@@ -238,6 +365,11 @@ func (c csvRow) Row() []string {
 		c.resourceName,
 		c.entitlementDescription,
 		c.entitlementSlug,
+		c.principalType,
+		c.principalID,
+		c.principalName,
+		c.principalDetails,
+		c.inheritedFrom,
 	}
 }
 
@@ -258,6 +390,50 @@ func getUserStatus(ctx context.Context, ut *v2.UserTrait) string {
 	}
 }
 
+// primaryEmail returns the user's primary email address, or an empty string if none is marked as primary.
+func primaryEmail(ut *v2.UserTrait) string {
+	for _, e := range ut.GetEmails() {
+		if e.IsPrimary {
+			return e.Address
+		}
+	}
+
+	return ""
+}
+
+// principalRow returns a row with the principal's columns set. Users also get their profile and email columns.
+func principalRow(ctx context.Context, rowType string, p *exportPrincipal) csvRow {
+	r := csvRow{
+		rowType:          rowType,
+		principalType:    p.principalType,
+		principalID:      p.id,
+		principalName:    p.resource.DisplayName,
+		principalDetails: p.details,
+	}
+
+	if p.user != nil {
+		profile := p.user.GetProfile().GetFields()
+		r.lastName = profile["last_name"].GetStringValue()
+		r.firstName = profile["first_name"].GetStringValue()
+		r.userID = profile["user_id"].GetStringValue()
+		r.userStatus = getUserStatus(ctx, p.user)
+		r.emailAddress = primaryEmail(p.user)
+	}
+
+	return r
+}
+
+func (c csvRow) withEntitlement(e *v2.Entitlement) csvRow {
+	c.entitlementDisplayName = e.DisplayName
+	c.entitlement = e.Id
+	c.resourceType = e.GetResource().GetId().GetResourceType()
+	c.resourceName = e.GetResource().GetDisplayName()
+	c.entitlementDescription = e.Description
+	c.entitlementSlug = e.Slug
+
+	return c
+}
+
 func buildCSV(ctx context.Context, d dataBag, outPath string) error {
 	l := ctxzap.Extract(ctx)
 	l.Debug("building CSV")
@@ -277,71 +453,29 @@ func buildCSV(ctx context.Context, d dataBag, outPath string) error {
 		return err
 	}
 
-	// Identity
-	var userTypes []string
-	for _, rt := range d.resourceTypes {
-		for _, t := range rt.Traits {
-			if t == v2.ResourceType_TRAIT_USER {
-				userTypes = append(userTypes, rt.Id)
-			}
-		}
+	principals, err := d.principals()
+	if err != nil {
+		return err
 	}
 
-	for _, userType := range userTypes {
-		var ok bool
-		var rs map[string]*v2.Resource
+	// Identities, and the other principals such as groups, apps and secrets
+	principalsByID := make(map[string]*exportPrincipal)
+	for _, p := range principals {
+		principalsByID[p.id] = p
 
-		rs, ok = d.resourcesByType[userType]
-		if !ok {
-			return err
+		rowType := "Principal"
+		if p.user != nil {
+			rowType = "Identity"
 		}
 
-		for _, r := range rs {
-			ut := &v2.UserTrait{}
-			annos := annotations.Annotations(r.Annotations)
-			ok, err = annos.Pick(ut)
-			if err != nil {
-				return err
-			}
-			if !ok {
-				continue
-			}
-
-			var emailAddress string
-			for _, e := range ut.Emails {
-				if e.IsPrimary {
-					emailAddress = e.Address
-					break
-				}
-			}
-
-			profile := ut.Profile.Fields
-			r := csvRow{
-				rowType:      "Identity",
-				lastName:     profile["last_name"].GetStringValue(),
-				firstName:    profile["first_name"].GetStringValue(),
-				userID:       profile["user_id"].GetStringValue(),
-				userStatus:   getUserStatus(ctx, ut),
-				emailAddress: emailAddress,
-			}
-
-			err = w.Write(r.Row())
-			if err != nil {
-				return err
-			}
+		err = w.Write(principalRow(ctx, rowType, p).Row())
+		if err != nil {
+			return err
 		}
 	}
 
-	for _, e := range d.entitlementsByID {
-		r := csvRow{
-			rowType:                "Entitlement",
-			entitlementDisplayName: e.DisplayName,
-			entitlement:            e.Id,
-			resourceType:           e.Resource.Id.ResourceType,
-			resourceName:           e.Resource.DisplayName,
-			entitlementDescription: e.Description,
-			entitlementSlug:        e.Slug,
-		}
+	for _, id := range sortedKeys(d.entitlementsByID) {
+		r := csvRow{rowType: "Entitlement"}.withEntitlement(d.entitlementsByID[id])
 
 		err = w.Write(r.Row())
 		if err != nil {
@@ -349,53 +483,35 @@ func buildCSV(ctx context.Context, d dataBag, outPath string) error {
 		}
 	}
 
-	for _, g := range d.grantsByID {
-		if p, ok := d.resourcesByID[fmtResourceID(g.Principal.Id)]; ok {
-			ut := &v2.UserTrait{}
+	for _, id := range sortedKeys(d.grantsByID) {
+		g := d.grantsByID[id]
 
-			annos := annotations.Annotations(p.Annotations)
-			ok, err = annos.Pick(ut)
-			if err != nil {
-				return err
-			}
-			if !ok {
-				continue
-			}
+		var e *v2.Entitlement
+		if en, ok := d.entitlementsByID[g.Entitlement.Id]; ok {
+			e = en
+		} else {
+			e = g.Entitlement
+		}
 
-			var emailAddress string
-			for _, e := range ut.Emails {
-				if e.IsPrimary {
-					emailAddress = e.Address
-					break
-				}
-			}
-			profile := ut.Profile.Fields
+		r := principalRow(ctx, "Grant", principalsByID[fmtResourceID(g.Principal.Id)]).withEntitlement(e)
+		r.userStatus = ""
+		r.principalDetails = ""
 
-			var e *v2.Entitlement
-			if en, ok := d.entitlementsByID[g.Entitlement.Id]; ok {
-				e = en
-			} else {
-				e = g.Entitlement
-			}
+		err = w.Write(r.Row())
+		if err != nil {
+			return err
+		}
+	}
 
-			r := csvRow{
-				rowType:                "Grant",
-				lastName:               profile["last_name"].GetStringValue(),
-				firstName:              profile["first_name"].GetStringValue(),
-				userID:                 profile["user_id"].GetStringValue(),
-				emailAddress:           emailAddress,
-				entitlementDisplayName: e.DisplayName,
-				entitlement:            e.Id,
-				resourceType:           e.Resource.Id.ResourceType,
-				resourceName:           e.Resource.DisplayName,
-				entitlementDescription: e.Description,
-				entitlementSlug:        e.Slug,
-			}
+	for _, ig := range d.inheritedGrants {
+		r := principalRow(ctx, "Grant", principalsByID[fmtResourceID(ig.principal)]).withEntitlement(ig.entitlement)
+		r.userStatus = ""
+		r.principalDetails = ""
+		r.inheritedFrom = strings.Join(ig.via, ";")
 
-			err = w.Write(r.Row())
-			if err != nil {
-				return err
-			}
+		err = w.Write(r.Row())
+		if err != nil {
+			return err
 		}
 	}
 
@@ -410,8 +526,93 @@ type dataBag struct {
 	entitlementsByType map[string]map[string]*v2.Entitlement
 	grantsByID         map[string]*v2.Grant
 	grantsByType       map[string]map[string]*v2.Grant
+	// inheritedGrants is only set when grants are expanded.
+	inheritedGrants []*inheritedGrant
 }
 
 func fmtResourceID(r *v2.ResourceId) string {
 	return fmt.Sprintf("%s:%s", r.ResourceType, r.Resource)
 }
+
+func newDataBag() dataBag {
+	return dataBag{
+		resourceTypes:      make(map[string]*v2.ResourceType),
+		resourcesByID:      make(map[string]*v2.Resource),
+		resourcesByType:    make(map[string]map[string]*v2.Resource),
+		entitlementsByID:   make(map[string]*v2.Entitlement),
+		entitlementsByType: make(map[string]map[string]*v2.Entitlement),
+		grantsByID:         make(map[string]*v2.Grant),
+		grantsByType:       make(map[string]map[string]*v2.Grant),
+	}
+}
+
+func (d dataBag) addResource(r *v2.Resource) {
+	id := fmtResourceID(r.Id)
+	d.resourcesByID[id] = r
+	if _, ok := d.resourcesByType[r.Id.ResourceType]; !ok {
+		d.resourcesByType[r.Id.ResourceType] = make(map[string]*v2.Resource)
+	}
+	d.resourcesByType[r.Id.ResourceType][id] = r
+}
+
+// filter returns the objects the filter selects. Resources outside the filter are kept when they are the resource of a
+// selected entitlement or the principal of a selected grant, so that every exported row can be resolved.
+func (d dataBag) filter(f exportFilter) dataBag {
+	if f.empty() {
+		return d
+	}
+
+	ret := newDataBag()
+	addResource := func(id *v2.ResourceId) {
+		r, ok := d.resourcesByID[fmtResourceID(id)]
+		if !ok {
+			return
+		}
+		ret.addResource(r)
+		if rt, ok := d.resourceTypes[id.ResourceType]; ok {
+			ret.resourceTypes[rt.Id] = rt
+		}
+	}
+
+	if f.entitlementID == "" {
+		for _, r := range d.resourcesByID {
+			if f.matchesResource(r.Id) {
+				addResource(r.Id)
+			}
+		}
+	}
+
+	for id, e := range d.entitlementsByID {
+		if !f.matchesEntitlement(e) {
+			continue
+		}
+
+		ret.entitlementsByID[id] = e
+		if e.GetResource().GetId() == nil {
+			continue
+		}
+
+		resourceID := fmtResourceID(e.Resource.Id)
+		if _, ok := ret.entitlementsByType[resourceID]; !ok {
+			ret.entitlementsByType[resourceID] = make(map[string]*v2.Entitlement)
+		}
+		ret.entitlementsByType[resourceID][id] = e
+		addResource(e.Resource.Id)
+	}
+
+	for id, g := range d.grantsByID {
+		if !f.matchesEntitlement(g.Entitlement) {
+			continue
+		}
+
+		ret.grantsByID[id] = g
+		principalID := fmtResourceID(g.Principal.Id)
+		if _, ok := ret.grantsByType[principalID]; !ok {
+			ret.grantsByType[principalID] = make(map[string]*v2.Grant)
+		}
+		ret.grantsByType[principalID][id] = g
+		addResource(g.Principal.Id)
+	}
+
+	return ret
+}
@@ -22,6 +22,8 @@ func entitlementsCmd() *cobra.Command {
 
 	addSyncIDFlag(cmd)
 	addResourceTypeFlag(cmd)
+	addPaginationFlags(cmd)
+	addPrivilegedOnlyFlag(cmd)
 
 	return cmd
 }
@@ -52,6 +54,16 @@ func runEntitlements(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	pager, err := newPaginator(cmd)
+	if err != nil {
+		return err
+	}
+
+	classifier, err := getPrivilegedOnlyClassifier(cmd)
+	if err != nil {
+		return err
+	}
+
 	m, err := manager.New(ctx, c1zPath)
 	if err != nil {
 		return err
@@ -73,9 +85,12 @@ func runEntitlements(cmd *cobra.Command, args []string) error {
 	sc := storecache.NewStoreCache(ctx, store)
 
 	var entitlements []*v1.EntitlementOutput
-	pageToken := ""
+	pageToken := pager.PageToken()
 	for {
-		req := &v2.EntitlementsServiceListEntitlementsRequest{PageToken: pageToken}
+		req := &v2.EntitlementsServiceListEntitlementsRequest{
+			PageSize:  pager.PageSize(),
+			PageToken: pageToken,
+		}
 
 		resp, err := store.ListEntitlements(ctx, req)
 		if err != nil {
@@ -90,6 +105,18 @@ func runEntitlements(cmd *cobra.Command, args []string) error {
 			if resourceType != "" && rt.Id != resourceType {
 				continue
 			}
+			if classifier != nil {
+				ok, err := classifier.IsPrivileged(ctx, sc, en)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					continue
+				}
+			}
+			if !pager.Take() || pager.CountOnly() {
+				continue
+			}
 			resource, err := sc.GetResource(ctx, en.Resource.Id)
 			if err != nil {
 				return err
@@ -102,15 +129,22 @@ func runEntitlements(cmd *cobra.Command, args []string) error {
 			})
 		}
 
-		if resp.NextPageToken == "" {
+		pageToken = resp.NextPageToken
+		if pageToken == "" || pager.Done() {
 			break
 		}
+	}
 
-		pageToken = resp.NextPageToken
+	if pager.CountOnly() {
+		return outputManager.Output(ctx, &v1.CountOutput{
+			Count:         pager.Count(),
+			NextPageToken: pageToken,
+		})
 	}
 
 	err = outputManager.Output(ctx, &v1.EntitlementListOutput{
-		Entitlements: entitlements,
+		Entitlements:  entitlements,
+		NextPageToken: pageToken,
 	})
 	if err != nil {
 		return err
@@ -32,6 +32,16 @@ func (h header) String() string {
 		return "Entitlement Description"
 	case headerEntitlementSlug:
 		return "Entitlement Slug"
+	case headerPrincipalType:
+		return "Principal Type"
+	case headerPrincipalID:
+		return "Principal ID"
+	case headerPrincipalName:
+		return "Principal Name"
+	case headerPrincipalDetails:
+		return "Principal Details"
+	case headerInheritedFrom:
+		return "Inherited From"
 
 	default:
 		return "unknown"
@@ -53,6 +63,11 @@ const (
 	headerResourceName
 	headerEntitlementDescription
 	headerEntitlementSlug
+	headerPrincipalType
+	headerPrincipalID
+	headerPrincipalName
+	headerPrincipalDetails
+	headerInheritedFrom
 	headerTerminator
 )
 
@@ -73,6 +88,8 @@ func export() *cobra.Command {
 
 	cmd.AddCommand(exportCSV())
 	cmd.AddCommand(exportXLSX())
+	cmd.AddCommand(exportSQLite())
+	cmd.AddCommand(exportParquet())
 	cmd.AddCommand(exportC1Z())
 
 	return cmd
@@ -0,0 +1,502 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/conductorone/baton-sdk/pkg/connectorstore"
+	"github.com/conductorone/baton-sdk/pkg/dotc1z/manager"
+	"github.com/conductorone/baton-sdk/pkg/logging"
+	"github.com/conductorone/baton/pkg/parquet"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
+	reader_v2 "github.com/conductorone/baton-sdk/pb/c1/reader/v2"
+)
+
+// parquetLookupCacheSize bounds the number of resources the access table keeps in memory for lookups.
+const parquetLookupCacheSize = 10000
+
+// Columns of the tables written by export parquet. Resource IDs are "<resource type>:<resource id>", as in the other
+// exports. The sync ID is not a column; it is the sync_id partition of the directory each file is written to.
+var (
+	parquetResourceColumns = []parquet.Column{
+		{Name: "id", Type: parquet.String, Doc: "Resource ID, as <resource type>:<resource id>."},
+		{Name: "resource_type", Type: parquet.String, Doc: "Resource type ID."},
+		{Name: "resource_id", Type: parquet.String, Doc: "Connector ID of the resource within its resource type."},
+		{Name: "display_name", Type: parquet.String, Doc: "Display name of the resource."},
+		{Name: "description", Type: parquet.String, Doc: "Description of the resource."},
+		{Name: "parent_id", Type: parquet.String, Doc: "Resource ID of the parent resource."},
+		{Name: "external_link", Type: parquet.String, Doc: "Link to the resource in the source system."},
+		{Name: "trait", Type: parquet.String, Doc: "Trait of the resource: user, group, role, app or secret. Null for other resources."},
+		{Name: "user_login", Type: parquet.String, Doc: "Login of a user."},
+		{Name: "user_email", Type: parquet.String, Doc: "Primary email address of a user."},
+		{Name: "user_status", Type: parquet.String, Doc: "Status of a user: Enabled, Disabled, Deleted or Unknown."},
+		{Name: "user_account_type", Type: parquet.String, Doc: "Account type of a user, such as ACCOUNT_TYPE_HUMAN."},
+		{Name: "user_created_at", Type: parquet.Timestamp, Doc: "When a user was created in the source system."},
+		{Name: "user_last_login", Type: parquet.Timestamp, Doc: "When a user last logged in."},
+		{Name: "user_mfa_enabled", Type: parquet.Bool, Doc: "Whether a user has MFA enabled."},
+		{Name: "user_sso_enabled", Type: parquet.Bool, Doc: "Whether a user signs in through SSO."},
+	}
+	parquetEntitlementColumns = []parquet.Column{
+		{Name: "id", Type: parquet.String, Doc: "Entitlement ID."},
+		{Name: "resource_id", Type: parquet.String, Doc: "Resource ID of the resource the entitlement is on."},
+		{Name: "resource_type", Type: parquet.String, Doc: "Resource type ID of the resource the entitlement is on."},
+		{Name: "display_name", Type: parquet.String, Doc: "Display name of the entitlement."},
+		{Name: "description", Type: parquet.String, Doc: "Description of the entitlement."},
+		{Name: "slug", Type: parquet.String, Doc: "Slug of the entitlement, such as member or admin."},
+		{Name: "purpose", Type: parquet.String, Doc: "Purpose of the entitlement, such as PURPOSE_VALUE_ASSIGNMENT."},
+		{Name: "grantable_to", Type: parquet.String, Doc: "Comma separated resource type IDs the entitlement can be granted to."},
+	}
+	parquetGrantColumns = []parquet.Column{
+		{Name: "id", Type: parquet.String, Doc: "Grant ID."},
+		{Name: "entitlement_id", Type: parquet.String, Doc: "ID of the granted entitlement."},
+		{Name: "principal_id", Type: parquet.String, Doc: "Resource ID of the principal holding the grant."},
+		{Name: "resource_id", Type: parquet.String, Doc: "Resource ID of the resource the entitlement is on."},
+		{Name: "sources", Type: parquet.String, Doc: "Comma separated entitlement IDs the grant was expanded from. Null for direct grants."},
+	}
+	parquetAccessColumns = []parquet.Column{
+		{Name: "grant_id", Type: parquet.String, Doc: "Grant ID."},
+		{Name: "principal_id", Type: parquet.String, Doc: "Resource ID of the principal holding the grant."},
+		{Name: "principal_type", Type: parquet.String, Doc: "Resource type ID of the principal."},
+		{Name: "principal_name", Type: parquet.String, Doc: "Display name of the principal."},
+		{Name: "principal_trait", Type: parquet.String, Doc: "Trait of the principal: user, group, role, app or secret. Null for other resources."},
+		{Name: "user_email", Type: parquet.String, Doc: "Primary email address of a user principal."},
+		{Name: "user_login", Type: parquet.String, Doc: "Login of a user principal."},
+		{Name: "user_status", Type: parquet.String, Doc: "Status of a user principal: Enabled, Disabled, Deleted or Unknown."},
+		{Name: "user_last_login", Type: parquet.Timestamp, Doc: "When a user principal last logged in."},
+		{Name: "user_mfa_enabled", Type: parquet.Bool, Doc: "Whether a user principal has MFA enabled."},
+		{Name: "entitlement_id", Type: parquet.String, Doc: "ID of the granted entitlement."},
+		{Name: "entitlement_name", Type: parquet.String, Doc: "Display name of the granted entitlement."},
+		{Name: "entitlement_slug", Type: parquet.String, Doc: "Slug of the granted entitlement."},
+		{Name: "resource_id", Type: parquet.String, Doc: "Resource ID of the resource the entitlement is on."},
+		{Name: "resource_type", Type: parquet.String, Doc: "Resource type ID of the resource the entitlement is on."},
+		{Name: "resource_name", Type: parquet.String, Doc: "Display name of the resource the entitlement is on."},
+	}
+)
+
+func exportParquet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "parquet",
+		Short: "Export resources, entitlements, grants and access as Parquet files partitioned by sync ID",
+		RunE:  runExportParquet,
+	}
+
+	cmd.Flags().String("out", "./parquet", "The directory to export to. Files are written to <out>/<table>/sync_id=<sync id>/.")
+	addSyncIDFlag(cmd)
+
+	return cmd
+}
+
+func runExportParquet(cmd *cobra.Command, args []string) error {
+	ctx, err := logging.Init(context.Background(), logging.WithLogFormat("console"), logging.WithLogLevel("error"))
+	if err != nil {
+		return err
+	}
+
+	c1zPath, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	outDir, err := cmd.Flags().GetString("out")
+	if err != nil {
+		return err
+	}
+
+	syncID, err := cmd.Flags().GetString("sync-id")
+	if err != nil {
+		return err
+	}
+
+	m, err := manager.New(ctx, c1zPath)
+	if err != nil {
+		return err
+	}
+	defer m.Close(ctx)
+
+	store, err := m.LoadC1Z(ctx)
+	if err != nil {
+		return err
+	}
+
+	if syncID == "" {
+		syncID, err = store.LatestFinishedSyncID(ctx, connectorstore.SyncTypeAny)
+		if err != nil {
+			return err
+		}
+		if syncID == "" {
+			return fmt.Errorf("no finished syncs found in %s", c1zPath)
+		}
+	}
+
+	err = store.ViewSync(ctx, syncID)
+	if err != nil {
+		return err
+	}
+
+	err = buildParquet(ctx, store, syncID, outDir)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// parquetTable writes one table of a sync partition. Rows go to a temporary file that replaces the partition's file
+// on commit, so a failed export leaves any earlier export of the sync in place.
+type parquetTable struct {
+	f    *os.File
+	w    *parquet.Writer
+	path string
+}
+
+func newParquetTable(outDir string, table string, syncID string, columns []parquet.Column) (*parquetTable, error) {
+	dir := filepath.Join(outDir, table, "sync_id="+syncID)
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(dir, "part-0.parquet")
+	f, err := os.Create(path + ".tmp")
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := parquet.NewWriter(f, columns)
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	w.SetMetadata("baton.table", table)
+	w.SetMetadata("baton.sync_id", syncID)
+
+	return &parquetTable{f: f, w: w, path: path}, nil
+}
+
+func (t *parquetTable) commit() error {
+	err := t.w.Close()
+	if err != nil {
+		return err
+	}
+
+	err = t.f.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(t.f.Name(), t.path)
+}
+
+// abort removes the temporary file. It is safe to call after commit.
+func (t *parquetTable) abort() {
+	_ = t.f.Close()
+	_ = os.Remove(t.f.Name())
+}
+
+// parquetString returns the string, or nil so that empty strings are written as nulls.
+func parquetString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+
+	return s
+}
+
+// parquetBool returns nil when the value is unknown so that it is written as a null.
+func parquetBool(v bool, known bool) interface{} {
+	if !known {
+		return nil
+	}
+
+	return v
+}
+
+func parquetTime(ts *timestamppb.Timestamp) interface{} {
+	if ts == nil {
+		return nil
+	}
+
+	return ts.AsTime()
+}
+
+func parquetResourceID(id *v2.ResourceId) interface{} {
+	if id == nil {
+		return nil
+	}
+
+	return fmtResourceID(id)
+}
+
+// parquetTrait returns the principal type of the principal, or nil for resources without a principal trait.
+func parquetTrait(p *exportPrincipal) interface{} {
+	if p.principalType == principalTypeResource {
+		return nil
+	}
+
+	return p.principalType
+}
+
+// resourceLookup looks up grant principals and entitlement resources for the access table. Lookups are cached, and
+// the cache is cleared when it is full so memory stays bounded however large the sync is.
+type resourceLookup struct {
+	store connectorstore.Reader
+	cache map[string]*exportPrincipal
+}
+
+func (r *resourceLookup) get(ctx context.Context, fallback *v2.Resource) (*exportPrincipal, error) {
+	key := fmtResourceID(fallback.Id)
+	if p, ok := r.cache[key]; ok {
+		return p, nil
+	}
+
+	resource := fallback
+	resp, err := r.store.GetResource(ctx, &reader_v2.ResourcesReaderServiceGetResourceRequest{ResourceId: fallback.Id})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	if resp.GetResource() != nil {
+		resource = resp.GetResource()
+	}
+
+	p, err := newExportPrincipal(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(r.cache) >= parquetLookupCacheSize {
+		clear(r.cache)
+	}
+	r.cache[key] = p
+
+	return p, nil
+}
+
+// buildParquet writes the sync the store is viewing. Each table is streamed a page at a time from the store.
+func buildParquet(ctx context.Context, store connectorstore.Reader, syncID string, outDir string) error {
+	l := ctxzap.Extract(ctx)
+	l.Debug("building Parquet")
+
+	var tables []*parquetTable
+	defer func() {
+		for _, t := range tables {
+			t.abort()
+		}
+	}()
+	newTable := func(name string, columns []parquet.Column) (*parquetTable, error) {
+		t, err := newParquetTable(outDir, name, syncID, columns)
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, t)
+		return t, nil
+	}
+
+	resources, err := newTable("resources", parquetResourceColumns)
+	if err != nil {
+		return err
+	}
+	entitlements, err := newTable("entitlements", parquetEntitlementColumns)
+	if err != nil {
+		return err
+	}
+	grants, err := newTable("grants", parquetGrantColumns)
+	if err != nil {
+		return err
+	}
+	access, err := newTable("access", parquetAccessColumns)
+	if err != nil {
+		return err
+	}
+
+	pageToken := ""
+	for {
+		resp, err := store.ListResources(ctx, &v2.ResourcesServiceListResourcesRequest{PageToken: pageToken})
+		if err != nil {
+			return err
+		}
+
+		for _, r := range resp.List {
+			err = writeParquetResource(ctx, resources.w, r)
+			if err != nil {
+				return err
+			}
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	pageToken = ""
+	for {
+		resp, err := store.ListEntitlements(ctx, &v2.EntitlementsServiceListEntitlementsRequest{PageToken: pageToken})
+		if err != nil {
+			return err
+		}
+
+		for _, e := range resp.List {
+			var grantableTo []string
+			for _, rt := range e.GrantableTo {
+				grantableTo = append(grantableTo, rt.Id)
+			}
+
+			err = entitlements.w.Write(
+				e.Id,
+				parquetResourceID(e.GetResource().GetId()),
+				parquetString(e.GetResource().GetId().GetResourceType()),
+				parquetString(e.DisplayName),
+				parquetString(e.Description),
+				parquetString(e.Slug),
+				e.Purpose.String(),
+				parquetString(strings.Join(grantableTo, ",")),
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	lookup := &resourceLookup{store: store, cache: make(map[string]*exportPrincipal)}
+	pageToken = ""
+	for {
+		resp, err := store.ListGrants(ctx, &v2.GrantsServiceListGrantsRequest{PageToken: pageToken})
+		if err != nil {
+			return err
+		}
+
+		for _, g := range resp.List {
+			if g.GetEntitlement().GetId() == "" || g.GetPrincipal().GetId() == nil {
+				l.Debug("skipping grant without an entitlement or principal")
+				continue
+			}
+
+			err = grants.w.Write(
+				g.Id,
+				g.Entitlement.Id,
+				fmtResourceID(g.Principal.Id),
+				parquetResourceID(g.Entitlement.GetResource().GetId()),
+				parquetString(strings.Join(sortedKeys(g.GetSources().GetSources()), ",")),
+			)
+			if err != nil {
+				return err
+			}
+
+			// Access rows describe the resource the entitlement is on, so grants for entitlements without one are
+			// only written to the grants table.
+			if g.Entitlement.GetResource().GetId() == nil {
+				continue
+			}
+
+			err = writeParquetAccess(ctx, access.w, lookup, g)
+			if err != nil {
+				return err
+			}
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	for _, t := range tables {
+		err = t.commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeParquetResource(ctx context.Context, w *parquet.Writer, r *v2.Resource) error {
+	p, err := newExportPrincipal(r)
+	if err != nil {
+		return err
+	}
+
+	link, err := externalLink(r)
+	if err != nil {
+		return err
+	}
+
+	values := []interface{}{
+		p.id, r.Id.ResourceType, r.Id.Resource, parquetString(r.DisplayName), parquetString(r.Description),
+		parquetResourceID(r.ParentResourceId), parquetString(link), parquetTrait(p),
+	}
+
+	if ut := p.user; ut != nil {
+		values = append(values,
+			parquetString(ut.Login),
+			parquetString(primaryEmail(ut)),
+			getUserStatus(ctx, ut),
+			ut.AccountType.String(),
+			parquetTime(ut.CreatedAt),
+			parquetTime(ut.LastLogin),
+			parquetBool(ut.GetMfaStatus().GetMfaEnabled(), ut.MfaStatus != nil),
+			parquetBool(ut.GetSsoStatus().GetSsoEnabled(), ut.SsoStatus != nil),
+		)
+	} else {
+		values = append(values, nil, nil, nil, nil, nil, nil, nil, nil)
+	}
+
+	return w.Write(values...)
+}
+
+func writeParquetAccess(ctx context.Context, w *parquet.Writer, lookup *resourceLookup, g *v2.Grant) error {
+	principal, err := lookup.get(ctx, g.Principal)
+	if err != nil {
+		return err
+	}
+
+	resource, err := lookup.get(ctx, g.Entitlement.Resource)
+	if err != nil {
+		return err
+	}
+
+	values := []interface{}{
+		g.Id,
+		principal.id,
+		g.Principal.Id.ResourceType,
+		parquetString(principal.resource.DisplayName),
+		parquetTrait(principal),
+	}
+
+	if ut := principal.user; ut != nil {
+		values = append(values,
+			parquetString(primaryEmail(ut)),
+			parquetString(ut.Login),
+			getUserStatus(ctx, ut),
+			parquetTime(ut.LastLogin),
+			parquetBool(ut.GetMfaStatus().GetMfaEnabled(), ut.MfaStatus != nil),
+		)
+	} else {
+		values = append(values, nil, nil, nil, nil, nil)
+	}
+
+	values = append(values,
+		g.Entitlement.Id,
+		parquetString(g.Entitlement.DisplayName),
+		parquetString(g.Entitlement.Slug),
+		resource.id,
+		g.Entitlement.Resource.Id.ResourceType,
+		parquetString(resource.resource.DisplayName),
+	)
+
+	return w.Write(values...)
+}
@@ -0,0 +1,297 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/conductorone/baton-sdk/pkg/annotations"
+	"github.com/conductorone/baton/pkg/expansion"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
+)
+
+// Principal types name the trait a principal was exported with.
+const (
+	principalTypeUser     = "user"
+	principalTypeGroup    = "group"
+	principalTypeRole     = "role"
+	principalTypeApp      = "app"
+	principalTypeSecret   = "secret"
+	principalTypeResource = "resource"
+)
+
+// principalTraits are the traits whose resources are exported as principals even when they hold no grants.
+var principalTraits = []v2.ResourceType_Trait{
+	v2.ResourceType_TRAIT_USER,
+	v2.ResourceType_TRAIT_GROUP,
+	v2.ResourceType_TRAIT_APP,
+	v2.ResourceType_TRAIT_SECRET,
+}
+
+// exportPrincipal is a principal row of an export, with the data of the trait it was exported with.
+type exportPrincipal struct {
+	id            string
+	resource      *v2.Resource
+	principalType string
+	user          *v2.UserTrait
+	app           *v2.AppTrait
+	secret        *v2.SecretTrait
+	// profile is the profile of the trait, if it has one.
+	profile *structpb.Struct
+	details string
+}
+
+// inheritedGrant is access a user holds through grant expansion rather than a grant of their own.
+type inheritedGrant struct {
+	principal   *v2.ResourceId
+	entitlement *v2.Entitlement
+	// via holds the entitlements that passed the access on to the principal.
+	via []string
+}
+
+func formatDetailTime(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+
+	return ts.AsTime().UTC().Format(time.RFC3339)
+}
+
+// traitDetails formats trait data as "key=value" pairs separated by semicolons. Empty values are skipped and profile
+// fields follow the named values in key order.
+func traitDetails(profile *structpb.Struct, pairs ...string) string {
+	var ret []string
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if pairs[i+1] == "" {
+			continue
+		}
+		ret = append(ret, fmt.Sprintf("%s=%s", pairs[i], pairs[i+1]))
+	}
+
+	fields := profile.GetFields()
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v := fields[k].AsInterface()
+		if v == nil {
+			continue
+		}
+		ret = append(ret, fmt.Sprintf("%s=%v", k, v))
+	}
+
+	return strings.Join(ret, "; ")
+}
+
+func newExportPrincipal(r *v2.Resource) (*exportPrincipal, error) {
+	p := &exportPrincipal{
+		id:            fmtResourceID(r.Id),
+		resource:      r,
+		principalType: principalTypeResource,
+	}
+	annos := annotations.Annotations(r.Annotations)
+
+	ut := &v2.UserTrait{}
+	ok, err := annos.Pick(ut)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		p.principalType = principalTypeUser
+		p.user = ut
+		p.profile = ut.Profile
+		return p, nil
+	}
+
+	gt := &v2.GroupTrait{}
+	ok, err = annos.Pick(gt)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		p.principalType = principalTypeGroup
+		p.profile = gt.Profile
+		p.details = traitDetails(gt.Profile)
+		return p, nil
+	}
+
+	at := &v2.AppTrait{}
+	ok, err = annos.Pick(at)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		var flags []string
+		for _, f := range at.Flags {
+			flags = append(flags, f.String())
+		}
+		p.principalType = principalTypeApp
+		p.app = at
+		p.profile = at.Profile
+		p.details = traitDetails(at.Profile, "help_url", at.HelpUrl, "flags", strings.Join(flags, ","))
+		return p, nil
+	}
+
+	st, err := getSecretTrait(r)
+	if err != nil {
+		return nil, err
+	}
+	if st != nil {
+		var identityID string
+		if id := secretIdentity(st); id != nil {
+			identityID = fmtResourceID(id)
+		}
+		p.principalType = principalTypeSecret
+		p.secret = st
+		p.profile = st.Profile
+		p.details = traitDetails(st.Profile,
+			"identity", identityID,
+			"created_at", formatDetailTime(st.CreatedAt),
+			"expires_at", formatDetailTime(st.ExpiresAt),
+			"last_used_at", formatDetailTime(st.LastUsedAt),
+		)
+		return p, nil
+	}
+
+	rt := &v2.RoleTrait{}
+	ok, err = annos.Pick(rt)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		p.principalType = principalTypeRole
+		p.profile = rt.Profile
+		p.details = traitDetails(rt.Profile)
+		return p, nil
+	}
+
+	return p, nil
+}
+
+// principals returns every resource of a principal resource type along with every other principal of a grant, sorted
+// by ID. Grant principals that are not in the export are taken from the grant itself.
+func (d dataBag) principals() ([]*exportPrincipal, error) {
+	resources := make(map[string]*v2.Resource)
+	for rtID, rt := range d.resourceTypes {
+		if !slices.ContainsFunc(rt.Traits, func(t v2.ResourceType_Trait) bool { return slices.Contains(principalTraits, t) }) {
+			continue
+		}
+		for id, r := range d.resourcesByType[rtID] {
+			resources[id] = r
+		}
+	}
+
+	for _, g := range d.grantsByID {
+		if g.GetPrincipal().GetId() == nil {
+			continue
+		}
+		id := fmtResourceID(g.Principal.Id)
+		if _, ok := resources[id]; ok {
+			continue
+		}
+		if r, ok := d.resourcesByID[id]; ok {
+			resources[id] = r
+		} else {
+			resources[id] = g.Principal
+		}
+	}
+
+	for _, ig := range d.inheritedGrants {
+		id := fmtResourceID(ig.principal)
+		if _, ok := resources[id]; ok {
+			continue
+		}
+		if r, ok := d.resourcesByID[id]; ok {
+			resources[id] = r
+		}
+	}
+
+	ret := make([]*exportPrincipal, 0, len(resources))
+	for _, id := range sortedKeys(resources) {
+		p, err := newExportPrincipal(resources[id])
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, p)
+	}
+
+	return ret, nil
+}
+
+// expandGrants adds the access that users inherit through grant expansion to the export, for the entitlements the
+// export holds. Users are taken from all, the unfiltered export. Access the store already records as a grant of the
+// user is skipped.
+func (d *dataBag) expandGrants(graph *expansion.Graph, all dataBag) error {
+	for _, principalID := range graph.Principals() {
+		id := fmtResourceID(principalID)
+		r, ok := all.resourcesByID[id]
+		if !ok {
+			continue
+		}
+
+		p, err := newExportPrincipal(r)
+		if err != nil {
+			return err
+		}
+		if p.principalType != principalTypeUser {
+			continue
+		}
+
+		held := make(map[string]struct{})
+		for _, g := range all.grantsByType[id] {
+			held[g.Entitlement.Id] = struct{}{}
+		}
+
+		added := false
+		for _, access := range graph.EffectiveAccess(principalID) {
+			if access.Direct {
+				continue
+			}
+			en, ok := d.entitlementsByID[access.EntitlementID]
+			if !ok {
+				continue
+			}
+			if _, ok := held[access.EntitlementID]; ok {
+				continue
+			}
+
+			var via []string
+			for _, e := range access.Via {
+				if !slices.Contains(via, e.SourceEntitlementID) {
+					via = append(via, e.SourceEntitlementID)
+				}
+			}
+
+			d.inheritedGrants = append(d.inheritedGrants, &inheritedGrant{
+				principal:   principalID,
+				entitlement: en,
+				via:         via,
+			})
+			added = true
+		}
+
+		if added {
+			d.addResource(r)
+			if rt, ok := all.resourceTypes[r.Id.ResourceType]; ok {
+				d.resourceTypes[rt.Id] = rt
+			}
+		}
+	}
+
+	sort.SliceStable(d.inheritedGrants, func(i, j int) bool {
+		a, b := d.inheritedGrants[i], d.inheritedGrants[j]
+		if fmtResourceID(a.principal) != fmtResourceID(b.principal) {
+			return fmtResourceID(a.principal) < fmtResourceID(b.principal)
+		}
+		return a.entitlement.Id < b.entitlement.Id
+	})
+
+	return nil
+}
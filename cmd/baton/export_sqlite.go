@@ -0,0 +1,479 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/conductorone/baton-sdk/pkg/logging"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
+
+	// Registers the sqlite database/sql driver.
+	_ "github.com/glebarez/go-sqlite"
+)
+
+// sqliteSchema is the normalized schema written by export sqlite. Resource IDs are "<resource type>:<resource id>", as
+// in the other exports, and timestamps are RFC 3339 text in UTC. Foreign keys are enforced when the export is written.
+var sqliteSchema = []string{
+	`CREATE TABLE resource_types (
+		id TEXT PRIMARY KEY,
+		display_name TEXT,
+		description TEXT,
+		traits TEXT
+	)`,
+	`CREATE TABLE resources (
+		id TEXT PRIMARY KEY,
+		resource_type_id TEXT NOT NULL REFERENCES resource_types (id),
+		resource_id TEXT NOT NULL,
+		display_name TEXT,
+		description TEXT,
+		parent_id TEXT REFERENCES resources (id),
+		external_link TEXT,
+		trait TEXT,
+		user_login TEXT,
+		user_email TEXT,
+		user_status TEXT,
+		user_account_type TEXT,
+		user_given_name TEXT,
+		user_family_name TEXT,
+		user_created_at TEXT,
+		user_last_login TEXT,
+		user_mfa_enabled INTEGER,
+		user_sso_enabled INTEGER,
+		app_help_url TEXT,
+		app_flags TEXT,
+		secret_identity_id TEXT,
+		secret_created_by_id TEXT,
+		secret_created_at TEXT,
+		secret_expires_at TEXT,
+		secret_last_used_at TEXT
+	)`,
+	`CREATE INDEX resources_resource_type_id ON resources (resource_type_id)`,
+	`CREATE INDEX resources_parent_id ON resources (parent_id)`,
+	`CREATE INDEX resources_user_email ON resources (user_email)`,
+	`CREATE TABLE user_emails (
+		resource_id TEXT NOT NULL REFERENCES resources (id),
+		address TEXT NOT NULL,
+		is_primary INTEGER NOT NULL,
+		PRIMARY KEY (resource_id, address)
+	)`,
+	`CREATE INDEX user_emails_address ON user_emails (address)`,
+	`CREATE TABLE resource_profiles (
+		resource_id TEXT NOT NULL REFERENCES resources (id),
+		key TEXT NOT NULL,
+		value TEXT,
+		PRIMARY KEY (resource_id, key)
+	)`,
+	`CREATE INDEX resource_profiles_key ON resource_profiles (key, value)`,
+	`CREATE TABLE entitlements (
+		id TEXT PRIMARY KEY,
+		resource_id TEXT REFERENCES resources (id),
+		display_name TEXT,
+		description TEXT,
+		slug TEXT,
+		purpose TEXT
+	)`,
+	`CREATE INDEX entitlements_resource_id ON entitlements (resource_id)`,
+	`CREATE TABLE grants (
+		id TEXT PRIMARY KEY,
+		entitlement_id TEXT NOT NULL REFERENCES entitlements (id),
+		principal_id TEXT NOT NULL REFERENCES resources (id),
+		resource_id TEXT REFERENCES resources (id)
+	)`,
+	`CREATE INDEX grants_entitlement_id ON grants (entitlement_id)`,
+	`CREATE INDEX grants_principal_id ON grants (principal_id)`,
+	`CREATE INDEX grants_resource_id ON grants (resource_id)`,
+	`CREATE TABLE inherited_grants (
+		principal_id TEXT NOT NULL REFERENCES resources (id),
+		entitlement_id TEXT NOT NULL REFERENCES entitlements (id),
+		inherited_from TEXT,
+		PRIMARY KEY (principal_id, entitlement_id)
+	)`,
+	`CREATE INDEX inherited_grants_entitlement_id ON inherited_grants (entitlement_id)`,
+}
+
+func exportSQLite() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sqlite",
+		Short: "Export a normalized SQLite database for BI tools",
+		RunE:  runExportSQLite,
+	}
+
+	cmd.Flags().String("out", "./sync.sqlite", "The path to export the SQLite database to. An existing file is replaced.")
+	addExportFlags(cmd)
+
+	return cmd
+}
+
+func runExportSQLite(cmd *cobra.Command, args []string) error {
+	ctx, err := logging.Init(context.Background(), logging.WithLogFormat("console"), logging.WithLogLevel("error"))
+	if err != nil {
+		return err
+	}
+
+	outPath, err := cmd.Flags().GetString("out")
+	if err != nil {
+		return err
+	}
+
+	d, err := loadExportData(ctx, cmd)
+	if err != nil {
+		return err
+	}
+
+	err = buildSQLite(ctx, d, outPath)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// sqlTime returns the timestamp as RFC 3339 text, or nil so that missing timestamps are stored as NULL.
+func sqlTime(ts *timestamppb.Timestamp) interface{} {
+	if ts == nil {
+		return nil
+	}
+
+	return ts.AsTime().UTC().Format(time.RFC3339)
+}
+
+// sqlString returns nil for empty strings so that they are stored as NULL.
+func sqlString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+
+	return s
+}
+
+func sqlResourceID(id *v2.ResourceId) interface{} {
+	if id == nil {
+		return nil
+	}
+
+	return fmtResourceID(id)
+}
+
+// sqlBool returns nil when the value is unknown so that it is stored as NULL.
+func sqlBool(v bool, known bool) interface{} {
+	if !known {
+		return nil
+	}
+
+	return v
+}
+
+// sqliteWriter inserts rows with prepared statements inside a single transaction.
+type sqliteWriter struct {
+	tx    *sql.Tx
+	stmts map[string]*sql.Stmt
+}
+
+func (w *sqliteWriter) insert(ctx context.Context, table string, columns []string, values ...interface{}) error {
+	stmt, ok := w.stmts[table]
+	if !ok {
+		query := "INSERT INTO " + table + " (" + strings.Join(columns, ", ") + ") VALUES (" +
+			strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ") + ")"
+
+		var err error
+		stmt, err = w.tx.PrepareContext(ctx, query)
+		if err != nil {
+			return err
+		}
+		w.stmts[table] = stmt
+	}
+
+	_, err := stmt.ExecContext(ctx, values...)
+	return err
+}
+
+func (w *sqliteWriter) close() {
+	for _, stmt := range w.stmts {
+		_ = stmt.Close()
+	}
+}
+
+var (
+	sqliteResourceTypeColumns = []string{"id", "display_name", "description", "traits"}
+	sqliteResourceColumns     = []string{
+		"id", "resource_type_id", "resource_id", "display_name", "description", "parent_id", "external_link", "trait",
+		"user_login", "user_email", "user_status", "user_account_type", "user_given_name", "user_family_name",
+		"user_created_at", "user_last_login", "user_mfa_enabled", "user_sso_enabled",
+		"app_help_url", "app_flags",
+		"secret_identity_id", "secret_created_by_id", "secret_created_at", "secret_expires_at", "secret_last_used_at",
+	}
+	sqliteUserEmailColumns      = []string{"resource_id", "address", "is_primary"}
+	sqliteProfileColumns        = []string{"resource_id", "key", "value"}
+	sqliteEntitlementColumns    = []string{"id", "resource_id", "display_name", "description", "slug", "purpose"}
+	sqliteGrantColumns          = []string{"id", "entitlement_id", "principal_id", "resource_id"}
+	sqliteInheritedGrantColumns = []string{"principal_id", "entitlement_id", "inherited_from"}
+)
+
+// insertResource writes the resource with its trait columns, emails and profile. Parents that are not in resources
+// are left NULL.
+func (w *sqliteWriter) insertResource(ctx context.Context, resources map[string]*v2.Resource, p *exportPrincipal) error {
+	r := p.resource
+
+	var parentID interface{}
+	if r.ParentResourceId != nil {
+		if _, ok := resources[fmtResourceID(r.ParentResourceId)]; ok {
+			parentID = fmtResourceID(r.ParentResourceId)
+		}
+	}
+
+	link, err := externalLink(r)
+	if err != nil {
+		return err
+	}
+
+	var trait interface{}
+	if p.principalType != principalTypeResource {
+		trait = p.principalType
+	}
+
+	values := []interface{}{
+		p.id, r.Id.ResourceType, r.Id.Resource, sqlString(r.DisplayName), sqlString(r.Description), parentID,
+		sqlString(link), trait,
+	}
+
+	if ut := p.user; ut != nil {
+		values = append(values,
+			sqlString(ut.Login),
+			sqlString(primaryEmail(ut)),
+			getUserStatus(ctx, ut),
+			ut.AccountType.String(),
+			sqlString(ut.GetStructuredName().GetGivenName()),
+			sqlString(ut.GetStructuredName().GetFamilyName()),
+			sqlTime(ut.CreatedAt),
+			sqlTime(ut.LastLogin),
+			sqlBool(ut.GetMfaStatus().GetMfaEnabled(), ut.MfaStatus != nil),
+			sqlBool(ut.GetSsoStatus().GetSsoEnabled(), ut.SsoStatus != nil),
+		)
+	} else {
+		values = append(values, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	}
+
+	if at := p.app; at != nil {
+		var flags []string
+		for _, f := range at.Flags {
+			flags = append(flags, f.String())
+		}
+		values = append(values, sqlString(at.HelpUrl), sqlString(strings.Join(flags, ",")))
+	} else {
+		values = append(values, nil, nil)
+	}
+
+	if st := p.secret; st != nil {
+		values = append(values,
+			sqlResourceID(st.IdentityId),
+			sqlResourceID(st.CreatedById),
+			sqlTime(st.CreatedAt),
+			sqlTime(st.ExpiresAt),
+			sqlTime(st.LastUsedAt),
+		)
+	} else {
+		values = append(values, nil, nil, nil, nil, nil)
+	}
+
+	err = w.insert(ctx, "resources", sqliteResourceColumns, values...)
+	if err != nil {
+		return err
+	}
+
+	if ut := p.user; ut != nil {
+		seen := make(map[string]struct{})
+		for _, e := range ut.Emails {
+			if e.Address == "" {
+				continue
+			}
+			if _, ok := seen[e.Address]; ok {
+				continue
+			}
+			seen[e.Address] = struct{}{}
+
+			err = w.insert(ctx, "user_emails", sqliteUserEmailColumns, p.id, e.Address, e.IsPrimary)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, key := range sortedKeys(p.profile.GetFields()) {
+		var value interface{}
+		if v := p.profile.Fields[key].AsInterface(); v != nil {
+			if s, ok := v.(string); ok {
+				value = s
+			} else {
+				b, err := p.profile.Fields[key].MarshalJSON()
+				if err != nil {
+					return err
+				}
+				value = string(b)
+			}
+		}
+
+		err = w.insert(ctx, "resource_profiles", sqliteProfileColumns, p.id, key, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func buildSQLite(ctx context.Context, d dataBag, outPath string) error {
+	l := ctxzap.Extract(ctx)
+	l.Debug("building SQLite")
+
+	err := os.Remove(outPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	db, err := sql.Open("sqlite", outPath+"?_pragma=foreign_keys(1)")
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	for _, stmt := range sqliteSchema {
+		_, err = db.ExecContext(ctx, stmt)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Grants can reference principals and entitlements that are not otherwise in the export, so collect everything the
+	// rows refer to first. Every foreign key then resolves. Grants without an entitlement or principal cannot be
+	// represented and are skipped, and entitlements without a resource are stored with a NULL resource.
+	resources := make(map[string]*v2.Resource)
+	for id, r := range d.resourcesByID {
+		resources[id] = r
+	}
+	principals, err := d.principals()
+	if err != nil {
+		return err
+	}
+	for _, p := range principals {
+		resources[p.id] = p.resource
+	}
+
+	entitlements := make(map[string]*v2.Entitlement)
+	for id, e := range d.entitlementsByID {
+		entitlements[id] = e
+	}
+	grants := make(map[string]*v2.Grant)
+	for id, g := range d.grantsByID {
+		if g.GetEntitlement().GetId() == "" || g.GetPrincipal().GetId() == nil {
+			continue
+		}
+		grants[id] = g
+		if _, ok := entitlements[g.Entitlement.Id]; !ok {
+			entitlements[g.Entitlement.Id] = g.Entitlement
+		}
+	}
+	for _, e := range entitlements {
+		rID := e.GetResource().GetId()
+		if rID == nil {
+			continue
+		}
+		id := fmtResourceID(rID)
+		if _, ok := resources[id]; !ok {
+			resources[id] = e.Resource
+		}
+	}
+
+	resourceTypes := make(map[string]*v2.ResourceType)
+	for id, rt := range d.resourceTypes {
+		resourceTypes[id] = rt
+	}
+	for _, r := range resources {
+		if _, ok := resourceTypes[r.Id.ResourceType]; !ok {
+			resourceTypes[r.Id.ResourceType] = &v2.ResourceType{Id: r.Id.ResourceType}
+		}
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// Rows are inserted in ID order, so a resource can be written before its parent. The foreign keys are checked when
+	// the transaction commits instead.
+	_, err = tx.ExecContext(ctx, "PRAGMA defer_foreign_keys = ON")
+	if err != nil {
+		return err
+	}
+
+	w := &sqliteWriter{
+		tx:    tx,
+		stmts: make(map[string]*sql.Stmt),
+	}
+	defer w.close()
+
+	for _, id := range sortedKeys(resourceTypes) {
+		rt := resourceTypes[id]
+
+		var traits []string
+		for _, t := range rt.Traits {
+			traits = append(traits, t.String())
+		}
+
+		err = w.insert(ctx, "resource_types", sqliteResourceTypeColumns,
+			rt.Id, sqlString(rt.DisplayName), sqlString(rt.Description), sqlString(strings.Join(traits, ",")))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, id := range sortedKeys(resources) {
+		p, err := newExportPrincipal(resources[id])
+		if err != nil {
+			return err
+		}
+
+		err = w.insertResource(ctx, resources, p)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, id := range sortedKeys(entitlements) {
+		e := entitlements[id]
+		err = w.insert(ctx, "entitlements", sqliteEntitlementColumns,
+			e.Id, sqlResourceID(e.GetResource().GetId()), sqlString(e.DisplayName), sqlString(e.Description), sqlString(e.Slug), e.Purpose.String())
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, id := range sortedKeys(grants) {
+		g := grants[id]
+		err = w.insert(ctx, "grants", sqliteGrantColumns,
+			g.Id, g.Entitlement.Id, fmtResourceID(g.Principal.Id), sqlResourceID(entitlements[g.Entitlement.Id].GetResource().GetId()))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, ig := range d.inheritedGrants {
+		err = w.insert(ctx, "inherited_grants", sqliteInheritedGrantColumns,
+			fmtResourceID(ig.principal), ig.entitlement.Id, sqlString(strings.Join(ig.via, ";")))
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
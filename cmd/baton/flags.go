@@ -1,13 +1,36 @@
 package main
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/conductorone/baton/pkg/identity"
+	"github.com/conductorone/baton/pkg/privileged"
 	"github.com/spf13/cobra"
+
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
 )
 
 const (
 	resourceTypeFlag = "resource-type"
 	resourceFlag     = "resource"
 	entitlementFlag  = "entitlement"
+	limitFlag        = "limit"
+	offsetFlag       = "offset"
+	pageTokenFlag    = "page-token"
+	countFlag        = "count"
+	expandFlag       = "expand"
+	principalFlag    = "principal"
+	matchKeyFlag     = "match-key"
+	idpFlag          = "idp"
+	appFlag          = "app"
+	manifestFlag     = "manifest"
+	overridesFlag    = "overrides"
+
+	privilegedOnlyFlag      = "privileged-only"
+	privilegedPatternFlag   = "privileged-pattern"
+	privilegedAllowlistFlag = "privileged-allowlist"
+	privilegedRolesFlag     = "privileged-roles"
 )
 
 func addResourceTypeFlag(cmd *cobra.Command) {
@@ -25,3 +48,162 @@ func addEntitlementFlag(cmd *cobra.Command) {
 func addSyncIDFlag(cmd *cobra.Command) {
 	cmd.Flags().String("sync-id", "", "The sync ID to view data for. Will use the latest completed sync if not set.")
 }
+
+func addPaginationFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint32(limitFlag, 0, "The maximum number of results to output. Outputs all results if not set.")
+	cmd.Flags().Uint32(offsetFlag, 0, "The number of results to skip before output begins")
+	cmd.Flags().String(pageTokenFlag, "", "The page token to resume listing from, as printed by a previous --limit run")
+	cmd.Flags().Bool(countFlag, false, "Only output the number of results")
+}
+
+func addExpandFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool(expandFlag, false, "Include access inherited through grant expansion (nested groups, roles, etc.)")
+}
+
+func addPrincipalFlag(cmd *cobra.Command) {
+	cmd.Flags().StringP(principalFlag, "p", "", "The principal to filter output by, in the form <resource-type>:<resource-id>")
+}
+
+func getPrincipalFlag(cmd *cobra.Command) (*v2.ResourceId, error) {
+	principal, err := cmd.Flags().GetString(principalFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceTypeID, resourceID, ok := strings.Cut(principal, ":")
+	if !ok || resourceTypeID == "" || resourceID == "" {
+		return nil, fmt.Errorf("--%s must be in the form <resource-type>:<resource-id>", principalFlag)
+	}
+
+	return &v2.ResourceId{
+		ResourceType: resourceTypeID,
+		Resource:     resourceID,
+	}, nil
+}
+
+func addPrivilegedFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(privilegedPatternFlag, privileged.DefaultPatterns, "Case-insensitive glob patterns matched against entitlement slugs and display names to classify them as privileged")
+	cmd.Flags().String(privilegedAllowlistFlag, "", "A file of entitlement IDs, one per line, that are always classified as privileged")
+	cmd.Flags().Bool(privilegedRolesFlag, true, "Classify entitlements on resources with the role trait as privileged")
+}
+
+func addPrivilegedOnlyFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool(privilegedOnlyFlag, false, "Only include privileged entitlements")
+	addPrivilegedFlags(cmd)
+}
+
+func getPrivilegedClassifier(cmd *cobra.Command) (*privileged.Classifier, error) {
+	patterns, err := cmd.Flags().GetStringSlice(privilegedPatternFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	allowlist, err := cmd.Flags().GetString(privilegedAllowlistFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := cmd.Flags().GetBool(privilegedRolesFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	return privileged.New(privileged.Options{
+		Patterns:      patterns,
+		AllowlistPath: allowlist,
+		Roles:         roles,
+	})
+}
+
+// getPrivilegedOnlyClassifier returns a classifier if --privileged-only is set, and nil otherwise.
+func getPrivilegedOnlyClassifier(cmd *cobra.Command) (*privileged.Classifier, error) {
+	privilegedOnly, err := cmd.Flags().GetBool(privilegedOnlyFlag)
+	if err != nil {
+		return nil, err
+	}
+	if !privilegedOnly {
+		return nil, nil
+	}
+
+	return getPrivilegedClassifier(cmd)
+}
+
+func addMatchKeyFlag(cmd *cobra.Command) {
+	addMatchKeyFlagWithDefault(cmd, identity.MatchKeys)
+}
+
+func addMatchKeyFlagWithDefault(cmd *cobra.Command, keys []string) {
+	cmd.Flags().StringSlice(matchKeyFlag, keys,
+		fmt.Sprintf("The keys used to match accounts across files, in order of precedence (%s)", identity.SupportedMatchKeys))
+}
+
+func getMatchKeys(cmd *cobra.Command) ([]string, error) {
+	keys, err := cmd.Flags().GetStringSlice(matchKeyFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	err = identity.ValidateMatchKeys(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func addManifestFlag(cmd *cobra.Command) {
+	cmd.Flags().String(manifestFlag, "", "A file listing c1z files or glob patterns to load, one per line")
+}
+
+func addOverridesFlag(cmd *cobra.Command) {
+	cmd.Flags().String(overridesFlag, "", "A YAML file mapping accounts that cannot be matched by key to the same person")
+}
+
+// getCorrelateOptions returns the options for correlating accounts into people from --match-key and --overrides.
+func getCorrelateOptions(cmd *cobra.Command) (identity.Options, error) {
+	keys, err := getMatchKeys(cmd)
+	if err != nil {
+		return identity.Options{}, err
+	}
+
+	overridesPath, err := cmd.Flags().GetString(overridesFlag)
+	if err != nil {
+		return identity.Options{}, err
+	}
+
+	opts := identity.Options{Keys: keys}
+	if overridesPath != "" {
+		opts.Overrides, err = identity.LoadOverrides(overridesPath)
+		if err != nil {
+			return identity.Options{}, err
+		}
+	}
+
+	return opts, nil
+}
+
+// addIdpAppFlags adds the flags for commands that compare an identity provider's c1z file against application c1z files.
+func addIdpAppFlags(cmd *cobra.Command) {
+	cmd.Flags().String(idpFlag, "", "The c1z file synced from the identity provider")
+	cmd.Flags().StringSlice(appFlag, nil, "The c1z files synced from applications. May be repeated.")
+}
+
+func getIdpAppFlags(cmd *cobra.Command) (string, []string, error) {
+	idpPath, err := cmd.Flags().GetString(idpFlag)
+	if err != nil {
+		return "", nil, err
+	}
+	if idpPath == "" {
+		return "", nil, fmt.Errorf("--%s is required", idpFlag)
+	}
+
+	appPaths, err := cmd.Flags().GetStringSlice(appFlag)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(appPaths) == 0 {
+		return "", nil, fmt.Errorf("--%s is required", appFlag)
+	}
+
+	return idpPath, appPaths, nil
+}
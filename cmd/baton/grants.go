@@ -28,13 +28,15 @@ func grantsCmd() *cobra.Command {
 	addResourceFlag(cmd)
 	addEntitlementFlag(cmd)
 	addSyncIDFlag(cmd)
+	addPaginationFlags(cmd)
+	addPrivilegedOnlyFlag(cmd)
 
 	cmd.MarkFlagsMutuallyExclusive(resourceFlag, entitlementFlag)
 
 	return cmd
 }
 
-func listGrantsForEntitlement(ctx context.Context, cmd *cobra.Command, store connectorstore.Reader, pageToken string) ([]*v2.Grant, string, error) {
+func listGrantsForEntitlement(ctx context.Context, cmd *cobra.Command, store connectorstore.Reader, pageSize uint32, pageToken string) ([]*v2.Grant, string, error) {
 	entitlementID, err := cmd.Flags().GetString(entitlementFlag)
 	if err != nil {
 		return nil, "", err
@@ -46,6 +48,7 @@ func listGrantsForEntitlement(ctx context.Context, cmd *cobra.Command, store con
 	entitlement := &v2.Entitlement{Id: entitlementID}
 	req := &reader_v2.GrantsReaderServiceListGrantsForEntitlementRequest{
 		Entitlement: entitlement,
+		PageSize:    pageSize,
 		PageToken:   pageToken,
 	}
 	resp, err := store.ListGrantsForEntitlement(ctx, req)
@@ -56,7 +59,7 @@ func listGrantsForEntitlement(ctx context.Context, cmd *cobra.Command, store con
 	return resp.List, resp.NextPageToken, nil
 }
 
-func listGrantsForResource(ctx context.Context, cmd *cobra.Command, store connectorstore.Reader, pageToken string) ([]*v2.Grant, string, error) {
+func listGrantsForResource(ctx context.Context, cmd *cobra.Command, store connectorstore.Reader, pageSize uint32, pageToken string) ([]*v2.Grant, string, error) {
 	resourceTypeID, err := cmd.Flags().GetString(resourceTypeFlag)
 	if err != nil {
 		return nil, "", err
@@ -75,6 +78,7 @@ func listGrantsForResource(ctx context.Context, cmd *cobra.Command, store connec
 	}}
 	req := &v2.GrantsServiceListGrantsRequest{
 		Resource:  resource,
+		PageSize:  pageSize,
 		PageToken: pageToken,
 	}
 	resp, err := store.ListGrants(ctx, req)
@@ -85,7 +89,7 @@ func listGrantsForResource(ctx context.Context, cmd *cobra.Command, store connec
 	return resp.List, resp.NextPageToken, nil
 }
 
-func listGrantsForResourceType(ctx context.Context, cmd *cobra.Command, store connectorstore.Reader, pageToken string) ([]*v2.Grant, string, error) {
+func listGrantsForResourceType(ctx context.Context, cmd *cobra.Command, store connectorstore.Reader, pageSize uint32, pageToken string) ([]*v2.Grant, string, error) {
 	resourceTypeID, err := cmd.Flags().GetString(resourceTypeFlag)
 	if err != nil {
 		return nil, "", err
@@ -97,6 +101,7 @@ func listGrantsForResourceType(ctx context.Context, cmd *cobra.Command, store co
 
 	req := &reader_v2.GrantsReaderServiceListGrantsForResourceTypeRequest{
 		ResourceTypeId: resourceTypeID,
+		PageSize:       pageSize,
 		PageToken:      pageToken,
 	}
 	resp, err := store.ListGrantsForResourceType(ctx, req)
@@ -107,8 +112,9 @@ func listGrantsForResourceType(ctx context.Context, cmd *cobra.Command, store co
 	return resp.List, resp.NextPageToken, nil
 }
 
-func listAllGrants(ctx context.Context, store connectorstore.Reader, pageToken string) ([]*v2.Grant, string, error) {
+func listAllGrants(ctx context.Context, store connectorstore.Reader, pageSize uint32, pageToken string) ([]*v2.Grant, string, error) {
 	req := &v2.GrantsServiceListGrantsRequest{
+		PageSize:  pageSize,
 		PageToken: pageToken,
 	}
 	resp, err := store.ListGrants(ctx, req)
@@ -140,6 +146,16 @@ func runGrants(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	pager, err := newPaginator(cmd)
+	if err != nil {
+		return err
+	}
+
+	classifier, err := getPrivilegedOnlyClassifier(cmd)
+	if err != nil {
+		return err
+	}
+
 	m, err := manager.New(ctx, c1zPath)
 	if err != nil {
 		return err
@@ -161,27 +177,51 @@ func runGrants(cmd *cobra.Command, args []string) error {
 	sc := storecache.NewStoreCache(ctx, store)
 
 	var grantOutputs []*v1.GrantOutput
-	pageToken := ""
+	pageToken := pager.PageToken()
 	for {
 		var grants []*v2.Grant
 		switch {
 		case cmd.Flags().Changed(resourceFlag):
-			grants, pageToken, err = listGrantsForResource(ctx, cmd, store, pageToken)
+			grants, pageToken, err = listGrantsForResource(ctx, cmd, store, pager.PageSize(), pageToken)
 		case cmd.Flags().Changed(resourceTypeFlag):
-			grants, pageToken, err = listGrantsForResourceType(ctx, cmd, store, pageToken)
+			grants, pageToken, err = listGrantsForResourceType(ctx, cmd, store, pager.PageSize(), pageToken)
 		case cmd.Flags().Changed(entitlementFlag):
-			grants, pageToken, err = listGrantsForEntitlement(ctx, cmd, store, pageToken)
+			grants, pageToken, err = listGrantsForEntitlement(ctx, cmd, store, pager.PageSize(), pageToken)
 		default:
-			grants, pageToken, err = listAllGrants(ctx, store, pageToken)
+			grants, pageToken, err = listAllGrants(ctx, store, pager.PageSize(), pageToken)
 		}
 		if err != nil {
 			return err
 		}
 
 		for _, g := range grants {
-			en, err := sc.GetEntitlement(ctx, g.Entitlement.Id)
-			if err != nil {
-				return err
+			// The entitlement is only looked up before paging when the classifier needs it, so skipped and counted
+			// rows don't cost an extra store lookup.
+			var en *v2.Entitlement
+			if classifier != nil {
+				en, err = sc.GetEntitlement(ctx, g.Entitlement.Id)
+				if err != nil {
+					return err
+				}
+
+				ok, err := classifier.IsPrivileged(ctx, sc, en)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					continue
+				}
+			}
+
+			if !pager.Take() || pager.CountOnly() {
+				continue
+			}
+
+			if en == nil {
+				en, err = sc.GetEntitlement(ctx, g.Entitlement.Id)
+				if err != nil {
+					return err
+				}
 			}
 
 			principal, err := sc.GetResource(ctx, g.Principal.Id)
@@ -208,12 +248,22 @@ func runGrants(cmd *cobra.Command, args []string) error {
 			})
 		}
 
-		if pageToken == "" {
+		if pageToken == "" || pager.Done() {
 			break
 		}
 	}
 
-	err = outputManager.Output(ctx, &v1.GrantListOutput{Grants: grantOutputs})
+	if pager.CountOnly() {
+		return outputManager.Output(ctx, &v1.CountOutput{
+			Count:         pager.Count(),
+			NextPageToken: pageToken,
+		})
+	}
+
+	err = outputManager.Output(ctx, &v1.GrantListOutput{
+		Grants:        grantOutputs,
+		NextPageToken: pageToken,
+	})
 	if err != nil {
 		return err
 	}
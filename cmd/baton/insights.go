@@ -0,0 +1,401 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/conductorone/baton-sdk/pkg/annotations"
+	"github.com/conductorone/baton-sdk/pkg/logging"
+	v1 "github.com/conductorone/baton/pb/baton/v1"
+	"github.com/conductorone/baton/pkg/expansion"
+	"github.com/conductorone/baton/pkg/identity"
+	"github.com/conductorone/baton/pkg/output"
+	"github.com/spf13/cobra"
+
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
+)
+
+const (
+	insightTypeRiskScore = "risk_score"
+	insightTypeIssue     = "issue"
+
+	insightTargetUser             = "user"
+	insightTargetAppUser          = "app_user"
+	insightTargetResource         = "resource"
+	insightTargetExternalResource = "external_resource"
+
+	severityCritical = "critical"
+	severityHigh     = "high"
+	severityMedium   = "medium"
+	severityLow      = "low"
+	severityUnknown  = "unknown"
+)
+
+// severities lists the insight severities from least to most severe.
+var severities = []string{severityUnknown, severityLow, severityMedium, severityHigh, severityCritical}
+
+func severityRank(severity string) int {
+	return slices.Index(severities, severity)
+}
+
+// issueSeverity maps the free-form severity of an issue onto one of the insight severities.
+func issueSeverity(severity string) string {
+	severity = strings.ToLower(strings.TrimSpace(severity))
+	switch {
+	case strings.HasPrefix(severity, "crit"):
+		return severityCritical
+	case strings.HasPrefix(severity, "high"):
+		return severityHigh
+	case strings.HasPrefix(severity, "med"), strings.HasPrefix(severity, "moderate"):
+		return severityMedium
+	case strings.HasPrefix(severity, "low"), strings.HasPrefix(severity, "info"):
+		return severityLow
+	default:
+		return severityUnknown
+	}
+}
+
+// riskFactorSeverity maps a risk factor severity onto one of the insight severities.
+func riskFactorSeverity(severity v2.RiskFactor_Severity) string {
+	switch severity {
+	case v2.RiskFactor_SEVERITY_CRITICAL:
+		return severityCritical
+	case v2.RiskFactor_SEVERITY_HIGH:
+		return severityHigh
+	case v2.RiskFactor_SEVERITY_MEDIUM:
+		return severityMedium
+	case v2.RiskFactor_SEVERITY_LOW:
+		return severityLow
+	default:
+		return severityUnknown
+	}
+}
+
+// scoreSeverity derives a severity from a normalized risk score when no risk factor carries one.
+func scoreSeverity(score uint32) string {
+	switch {
+	case score >= 90:
+		return severityCritical
+	case score >= 70:
+		return severityHigh
+	case score >= 40:
+		return severityMedium
+	case score > 0:
+		return severityLow
+	default:
+		return severityUnknown
+	}
+}
+
+func insightsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "insights [c1z files...]",
+		Short: "List security insights and risk scores ranked by severity, along with the access held by the affected identities",
+		RunE:  runInsights,
+	}
+
+	cmd.Flags().Uint32("min-score", 0, "Only list risk scores with at least this normalized score")
+	cmd.Flags().String("min-severity", severityUnknown, fmt.Sprintf("Only list insights with at least this severity (%s)", strings.Join(severities, ", ")))
+	addSyncIDFlag(cmd)
+
+	return cmd
+}
+
+type sourcedResource struct {
+	source   *c1zSource
+	resource *v2.Resource
+}
+
+// insightResolver finds the resources that insights target across every loaded file.
+type insightResolver struct {
+	sources       []*c1zSource
+	sourcesByPath map[string]*c1zSource
+	accounts      *identity.Index
+	accountsByID  map[string][]*identity.Account
+	// resourcesByID is loaded the first time an external resource target is resolved.
+	resourcesByID map[string][]*sourcedResource
+}
+
+func newInsightResolver(ctx context.Context, sources []*c1zSource) (*insightResolver, error) {
+	r := &insightResolver{
+		sources:       sources,
+		sourcesByPath: make(map[string]*c1zSource),
+		accountsByID:  make(map[string][]*identity.Account),
+	}
+
+	var accounts []*identity.Account
+	for _, s := range sources {
+		r.sourcesByPath[s.path] = s
+
+		sourceAccounts, err := s.userAccounts(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range sourceAccounts {
+			r.accountsByID[a.Resource.Id.Resource] = append(r.accountsByID[a.Resource.Id.Resource], a)
+		}
+		accounts = append(accounts, sourceAccounts...)
+	}
+	r.accounts = identity.NewIndex([]string{identity.KeyEmail}, accounts)
+
+	return r, nil
+}
+
+func (r *insightResolver) fromAccounts(accounts []*identity.Account) []*sourcedResource {
+	var ret []*sourcedResource
+	for _, a := range accounts {
+		ret = append(ret, &sourcedResource{
+			source:   r.sourcesByPath[a.Source],
+			resource: a.Resource,
+		})
+	}
+
+	return ret
+}
+
+func (r *insightResolver) externalResources(ctx context.Context, externalID string) ([]*sourcedResource, error) {
+	if r.resourcesByID == nil {
+		r.resourcesByID = make(map[string][]*sourcedResource)
+		for _, s := range r.sources {
+			err := s.listResources(ctx, func(rt *v2.ResourceType) bool { return true }, func(res *v2.Resource) error {
+				r.resourcesByID[res.Id.Resource] = append(r.resourcesByID[res.Id.Resource], &sourcedResource{source: s, resource: res})
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return r.resourcesByID[externalID], nil
+}
+
+// Resolve returns the insight's target type, a description of the target, and the resources it refers to. Resource ID
+// targets are looked up in the insight's own file first.
+func (r *insightResolver) Resolve(ctx context.Context, s *c1zSource, trait *v2.SecurityInsightTrait) (string, string, []*sourcedResource, error) {
+	switch {
+	case trait.GetUser() != nil:
+		email := trait.GetUser().GetEmail()
+		return insightTargetUser, email, r.fromAccounts(r.accounts.Lookup(identity.KeyEmail, email)), nil
+
+	case trait.GetAppUser() != nil:
+		appUser := trait.GetAppUser()
+		target := appUser.GetExternalId()
+		if target == "" {
+			target = appUser.GetEmail()
+		}
+		accounts := r.accountsByID[appUser.GetExternalId()]
+		if len(accounts) == 0 && appUser.GetEmail() != "" {
+			accounts = r.accounts.Lookup(identity.KeyEmail, appUser.GetEmail())
+		}
+		return insightTargetAppUser, target, r.fromAccounts(accounts), nil
+
+	case trait.GetResourceId() != nil:
+		rid := trait.GetResourceId()
+		target := expansion.ResourceKey(rid)
+		for _, candidate := range append([]*c1zSource{s}, r.sources...) {
+			exists, err := candidate.sc.ResourceExists(ctx, rid)
+			if err != nil {
+				return "", "", nil, err
+			}
+			if !exists {
+				continue
+			}
+
+			res, err := candidate.sc.GetResource(ctx, rid)
+			if err != nil {
+				return "", "", nil, err
+			}
+			return insightTargetResource, target, []*sourcedResource{{source: candidate, resource: res}}, nil
+		}
+		return insightTargetResource, target, nil, nil
+
+	case trait.GetExternalResource() != nil:
+		external := trait.GetExternalResource()
+		target := external.GetExternalId()
+		if external.GetAppHint() != "" {
+			target = fmt.Sprintf("%s (%s)", target, external.GetAppHint())
+		}
+		resources, err := r.externalResources(ctx, external.GetExternalId())
+		if err != nil {
+			return "", "", nil, err
+		}
+		return insightTargetExternalResource, target, resources, nil
+
+	default:
+		return "", "", nil, nil
+	}
+}
+
+func insightOutput(ctx context.Context, s *c1zSource, resolver *insightResolver, r *v2.Resource, trait *v2.SecurityInsightTrait) (*v1.InsightOutput, error) {
+	resourceType, err := s.sc.GetResourceType(ctx, r.Id.ResourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &v1.InsightOutput{
+		File:         s.path,
+		Insight:      r,
+		ResourceType: resourceType,
+		ObservedAt:   trait.GetObservedAt(),
+		Severity:     severityUnknown,
+	}
+
+	switch {
+	case trait.GetRiskScore() != nil:
+		score := trait.GetRiskScore()
+		ret.InsightType = insightTypeRiskScore
+		ret.Value = score.GetSourceScore()
+		if ret.Value == "" {
+			ret.Value = score.GetValue() //nolint:staticcheck // Older connectors only set the deprecated value.
+		}
+		ret.NormalizedScore = score.GetNormalizedScore()
+
+		for _, f := range score.GetRiskFactors() {
+			severity := riskFactorSeverity(f.GetSeverity())
+			ret.RiskFactors = append(ret.RiskFactors, fmt.Sprintf("%s (%s)", f.GetDescription(), severity))
+			if severityRank(severity) > severityRank(ret.Severity) {
+				ret.Severity = severity
+			}
+		}
+		ret.RiskFactors = append(ret.RiskFactors, score.GetFactors()...) //nolint:staticcheck // Older connectors only set the deprecated factors.
+		if ret.Severity == severityUnknown {
+			ret.Severity = scoreSeverity(ret.NormalizedScore)
+		}
+
+	case trait.GetIssue() != nil:
+		ret.InsightType = insightTypeIssue
+		ret.Value = trait.GetIssue().GetValue()
+		ret.Severity = issueSeverity(trait.GetIssue().GetSeverity())
+	}
+
+	targetType, target, targets, err := resolver.Resolve(ctx, s, trait)
+	if err != nil {
+		return nil, err
+	}
+	ret.TargetType = targetType
+	ret.Target = target
+
+	for _, t := range targets {
+		targetResourceType, err := t.source.sc.GetResourceType(ctx, t.resource.Id.ResourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		out := &v1.InsightTargetOutput{
+			File:         t.source.path,
+			Resource:     t.resource,
+			ResourceType: targetResourceType,
+		}
+		out.Entitlements, out.InheritedEntitlements, err = t.source.effectiveEntitlements(ctx, t.resource.Id)
+		if err != nil {
+			return nil, err
+		}
+		ret.Targets = append(ret.Targets, out)
+	}
+
+	return ret, nil
+}
+
+func runInsights(cmd *cobra.Command, args []string) error {
+	ctx, err := logging.Init(context.Background(), logging.WithLogFormat("console"), logging.WithLogLevel("error"))
+	if err != nil {
+		return err
+	}
+
+	c1zPaths, err := getC1ZPaths(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	outputFormat, err := cmd.Flags().GetString("output-format")
+	if err != nil {
+		return err
+	}
+	outputManager := output.NewManager(ctx, outputFormat)
+
+	syncID, err := cmd.Flags().GetString("sync-id")
+	if err != nil {
+		return err
+	}
+
+	minScore, err := cmd.Flags().GetUint32("min-score")
+	if err != nil {
+		return err
+	}
+
+	minSeverity, err := cmd.Flags().GetString("min-severity")
+	if err != nil {
+		return err
+	}
+	minSeverity = strings.ToLower(minSeverity)
+	if severityRank(minSeverity) < 0 {
+		return fmt.Errorf("--min-severity must be one of %s", strings.Join(severities, ", "))
+	}
+
+	sources, err := openC1ZSources(ctx, c1zPaths, syncID)
+	defer closeC1ZSources(ctx, sources)
+	if err != nil {
+		return err
+	}
+
+	resolver, err := newInsightResolver(ctx, sources)
+	if err != nil {
+		return err
+	}
+
+	report := &v1.InsightsOutput{Files: c1zPaths}
+	for _, s := range sources {
+		err = s.resourcesWithTrait(ctx, v2.ResourceType_TRAIT_SECURITY_INSIGHT, func(r *v2.Resource) error {
+			trait := &v2.SecurityInsightTrait{}
+			annos := annotations.Annotations(r.Annotations)
+			ok, err := annos.Pick(trait)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return nil
+			}
+
+			insight, err := insightOutput(ctx, s, resolver, r, trait)
+			if err != nil {
+				return err
+			}
+
+			if insight.InsightType == insightTypeRiskScore && insight.NormalizedScore < minScore {
+				return nil
+			}
+			if severityRank(insight.Severity) < severityRank(minSeverity) {
+				return nil
+			}
+
+			if len(insight.Targets) == 0 {
+				report.Unresolved++
+			}
+			report.Insights = append(report.Insights, insight)
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	sort.SliceStable(report.Insights, func(i, j int) bool {
+		a, b := report.Insights[i], report.Insights[j]
+		if severityRank(a.Severity) != severityRank(b.Severity) {
+			return severityRank(a.Severity) > severityRank(b.Severity)
+		}
+		return a.NormalizedScore > b.NormalizedScore
+	})
+
+	err = outputManager.Output(ctx, report)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+
+	"github.com/conductorone/baton-sdk/pkg/annotations"
+	"github.com/conductorone/baton-sdk/pkg/logging"
+	v1 "github.com/conductorone/baton/pb/baton/v1"
+	"github.com/conductorone/baton/pkg/identity"
+	"github.com/conductorone/baton/pkg/output"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	c1zpb "github.com/conductorone/baton-sdk/pb/c1/c1z/v1"
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
+	reader_v2 "github.com/conductorone/baton-sdk/pb/c1/reader/v2"
+)
+
+func leaversCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "leavers",
+		Short: "List disabled or deleted identity provider users whose application accounts are still enabled or still hold access",
+		RunE:  runLeavers,
+	}
+
+	addIdpAppFlags(cmd)
+	addMatchKeyFlag(cmd)
+
+	return cmd
+}
+
+// userHistory walks the source's full syncs from newest to oldest and returns when the user was first seen inactive
+// after last being seen enabled. Both are nil if no sync saw the user enabled.
+func userHistory(
+	ctx context.Context,
+	s *c1zSource,
+	syncs []*syncRunInfo,
+	resourceID *v2.ResourceId,
+) (*timestamppb.Timestamp, *timestamppb.Timestamp, error) {
+	var disabledAt *timestamppb.Timestamp
+	for i := len(syncs) - 1; i >= 0; i-- {
+		sr := syncs[i]
+
+		var annos annotations.Annotations
+		annos.Update(&c1zpb.SyncDetails{Id: sr.id})
+		resp, err := s.store.GetResource(ctx, &reader_v2.ResourcesReaderServiceGetResourceRequest{
+			ResourceId:  resourceID,
+			Annotations: annos,
+		})
+		if err != nil {
+			// The user did not exist yet, so there is nothing further back to look at.
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, nil, nil
+			}
+			return nil, nil, err
+		}
+
+		a, err := identity.NewAccount(s.path, resp.Resource)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if a.User == nil || !isUserInactive(a.User) {
+			return disabledAt, timestamppb.New(sr.startedAt), nil
+		}
+		disabledAt = timestamppb.New(sr.startedAt)
+	}
+
+	return nil, nil, nil
+}
+
+func leaverAccountOutput(ctx context.Context, s *c1zSource, a *identity.Account, matchKey string) (*v1.LeaverAccountOutput, error) {
+	resourceType, err := s.sc.GetResourceType(ctx, a.Resource.Id.ResourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &v1.LeaverAccountOutput{
+		File:         s.path,
+		Account:      a.Resource,
+		ResourceType: resourceType,
+		Status:       getUserStatus(ctx, a.User),
+		MatchKey:     matchKey,
+	}
+
+	ret.Entitlements, ret.InheritedEntitlements, err = s.effectiveEntitlements(ctx, a.Resource.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func runLeavers(cmd *cobra.Command, args []string) error {
+	ctx, err := logging.Init(context.Background(), logging.WithLogFormat("console"), logging.WithLogLevel("error"))
+	if err != nil {
+		return err
+	}
+
+	outputFormat, err := cmd.Flags().GetString("output-format")
+	if err != nil {
+		return err
+	}
+	outputManager := output.NewManager(ctx, outputFormat)
+
+	idpPath, appPaths, err := getIdpAppFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	matchKeys, err := getMatchKeys(cmd)
+	if err != nil {
+		return err
+	}
+
+	sources, err := openC1ZSources(ctx, append([]string{idpPath}, appPaths...), "")
+	defer closeC1ZSources(ctx, sources)
+	if err != nil {
+		return err
+	}
+	idp, apps := sources[0], sources[1:]
+
+	idpAccounts, err := idp.userAccounts(ctx)
+	if err != nil {
+		return err
+	}
+
+	appIndexes := make([]*identity.Index, len(apps))
+	for i, s := range apps {
+		accounts, err := s.userAccounts(ctx)
+		if err != nil {
+			return err
+		}
+		appIndexes[i] = identity.NewIndex(matchKeys, accounts)
+	}
+
+	syncs, err := idp.fullSyncs(ctx)
+	if err != nil {
+		return err
+	}
+
+	report := &v1.LeaversOutput{
+		Idp:       idpPath,
+		Apps:      appPaths,
+		MatchKeys: matchKeys,
+	}
+	for _, idpAccount := range idpAccounts {
+		if idpAccount.User == nil || !isUserInactive(idpAccount.User) {
+			continue
+		}
+		report.IdpInactiveUsers++
+
+		var accounts []*v1.LeaverAccountOutput
+		for i, s := range apps {
+			matches, matchKey := appIndexes[i].Match(idpAccount)
+			for _, a := range matches {
+				acct, err := leaverAccountOutput(ctx, s, a, matchKey)
+				if err != nil {
+					return err
+				}
+
+				appEnabled := a.User != nil && !isUserInactive(a.User)
+				if !appEnabled && len(acct.Entitlements) == 0 && len(acct.InheritedEntitlements) == 0 {
+					continue
+				}
+				accounts = append(accounts, acct)
+			}
+		}
+		if len(accounts) == 0 {
+			continue
+		}
+
+		resourceType, err := idp.sc.GetResourceType(ctx, idpAccount.Resource.Id.ResourceType)
+		if err != nil {
+			return err
+		}
+
+		disabledAt, lastSeenEnabledAt, err := userHistory(ctx, idp, syncs, idpAccount.Resource.Id)
+		if err != nil {
+			return err
+		}
+
+		report.Leavers = append(report.Leavers, &v1.LeaverOutput{
+			User:              idpAccount.Resource,
+			ResourceType:      resourceType,
+			Email:             idpAccount.PrimaryEmail(),
+			Status:            getUserStatus(ctx, idpAccount.User),
+			DisabledAt:        disabledAt,
+			LastSeenEnabledAt: lastSeenEnabledAt,
+			Accounts:          accounts,
+		})
+	}
+
+	// Users who left longest ago come first, followed by those whose disable date is unknown.
+	sort.SliceStable(report.Leavers, func(i, j int) bool {
+		di, dj := report.Leavers[i].DisabledAt, report.Leavers[j].DisabledAt
+		if di == nil || dj == nil {
+			return di != nil
+		}
+		return di.AsTime().Before(dj.AsTime())
+	})
+
+	err = outputManager.Output(ctx, report)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -20,7 +20,7 @@ func main() {
 	}
 
 	cliCmd.PersistentFlags().StringP("file", "f", "sync.c1z", "The path to the c1z file to work with.")
-	cliCmd.PersistentFlags().StringP("output-format", "o", "console", "The format to output results in: (console, json)")
+	cliCmd.PersistentFlags().StringP("output-format", "o", "console", "The format to output results in: (console, json, csv)")
 
 	cliCmd.AddCommand(resourcesCmd())
 	cliCmd.AddCommand(resourceTypesCmd())
@@ -35,6 +35,16 @@ func main() {
 	cliCmd.AddCommand(syncsCmd())
 	cliCmd.AddCommand(optimizeDb())
 	cliCmd.AddCommand(explorerCmd())
+	cliCmd.AddCommand(whoCanAccessCmd())
+	cliCmd.AddCommand(sodCmd())
+	cliCmd.AddCommand(reportCmd())
+	cliCmd.AddCommand(orphansCmd())
+	cliCmd.AddCommand(leaversCmd())
+	cliCmd.AddCommand(insightsCmd())
+	cliCmd.AddCommand(analyzeCmd())
+	cliCmd.AddCommand(treeCmd())
+	cliCmd.AddCommand(personCmd())
+	cliCmd.AddCommand(reconcileCmd())
 
 	err := cliCmd.ExecuteContext(ctx)
 	if err != nil {
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"sort"
+
+	"github.com/conductorone/baton-sdk/pkg/logging"
+	v1 "github.com/conductorone/baton/pb/baton/v1"
+	"github.com/conductorone/baton/pkg/identity"
+	"github.com/conductorone/baton/pkg/output"
+	"github.com/spf13/cobra"
+
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
+)
+
+func orphansCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orphans",
+		Short: "List application accounts that do not match any user in the identity provider, along with the access they hold",
+		RunE:  runOrphans,
+	}
+
+	addIdpAppFlags(cmd)
+	addMatchKeyFlag(cmd)
+	cmd.Flags().Bool("include-disabled", false, "Include application accounts that are already disabled or deleted")
+
+	return cmd
+}
+
+func isUserInactive(ut *v2.UserTrait) bool {
+	status := ut.GetStatus().GetStatus()
+	return status == v2.UserTrait_Status_STATUS_DISABLED || status == v2.UserTrait_Status_STATUS_DELETED
+}
+
+func orphanAccountOutput(ctx context.Context, s *c1zSource, a *identity.Account) (*v1.OrphanAccountOutput, error) {
+	resourceType, err := s.sc.GetResourceType(ctx, a.Resource.Id.ResourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &v1.OrphanAccountOutput{
+		File:         s.path,
+		Account:      a.Resource,
+		ResourceType: resourceType,
+		Email:        a.PrimaryEmail(),
+		Login:        a.User.GetLogin(),
+		Status:       getUserStatus(ctx, a.User),
+		AccountType:  a.User.GetAccountType().String(),
+	}
+
+	ret.Entitlements, ret.InheritedEntitlements, err = s.effectiveEntitlements(ctx, a.Resource.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func runOrphans(cmd *cobra.Command, args []string) error {
+	ctx, err := logging.Init(context.Background(), logging.WithLogFormat("console"), logging.WithLogLevel("error"))
+	if err != nil {
+		return err
+	}
+
+	outputFormat, err := cmd.Flags().GetString("output-format")
+	if err != nil {
+		return err
+	}
+	outputManager := output.NewManager(ctx, outputFormat)
+
+	idpPath, appPaths, err := getIdpAppFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	matchKeys, err := getMatchKeys(cmd)
+	if err != nil {
+		return err
+	}
+
+	includeDisabled, err := cmd.Flags().GetBool("include-disabled")
+	if err != nil {
+		return err
+	}
+
+	sources, err := openC1ZSources(ctx, append([]string{idpPath}, appPaths...), "")
+	defer closeC1ZSources(ctx, sources)
+	if err != nil {
+		return err
+	}
+
+	idpAccounts, err := sources[0].userAccounts(ctx)
+	if err != nil {
+		return err
+	}
+	idx := identity.NewIndex(matchKeys, idpAccounts)
+
+	report := &v1.OrphansOutput{
+		Idp:       idpPath,
+		Apps:      appPaths,
+		MatchKeys: matchKeys,
+		IdpUsers:  uint32(len(idpAccounts)),
+	}
+	for _, s := range sources[1:] {
+		accounts, err := s.userAccounts(ctx)
+		if err != nil {
+			return err
+		}
+
+		summary := &v1.OrphanAppSummary{File: s.path}
+		for _, a := range accounts {
+			if a.User == nil {
+				continue
+			}
+			if !includeDisabled && isUserInactive(a.User) {
+				continue
+			}
+			summary.Accounts++
+
+			if matches, _ := idx.Match(a); len(matches) > 0 {
+				summary.Matched++
+				continue
+			}
+			summary.Orphaned++
+
+			orphan, err := orphanAccountOutput(ctx, s, a)
+			if err != nil {
+				return err
+			}
+			report.Orphans = append(report.Orphans, orphan)
+		}
+		report.AppsSummary = append(report.AppsSummary, summary)
+	}
+
+	sort.SliceStable(report.Orphans, func(i, j int) bool {
+		if report.Orphans[i].File != report.Orphans[j].File {
+			return report.Orphans[i].File < report.Orphans[j].File
+		}
+		li := len(report.Orphans[i].Entitlements) + len(report.Orphans[i].InheritedEntitlements)
+		lj := len(report.Orphans[j].Entitlements) + len(report.Orphans[j].InheritedEntitlements)
+		return li > lj
+	})
+
+	err = outputManager.Output(ctx, report)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// maxPageSize mirrors the largest page the c1z store will return in a single request.
+const maxPageSize = 10000
+
+// paginator applies the --limit, --offset and --page-token flags while a command walks pages from the store.
+// Page sizes are chosen so that a page never holds more rows than are still needed, which means the store's
+// next page token is always a valid place to resume from once the limit has been reached.
+type paginator struct {
+	limit     uint32
+	offset    uint32
+	pageToken string
+	count     bool
+
+	skipped uint32
+	taken   uint32
+}
+
+func newPaginator(cmd *cobra.Command) (*paginator, error) {
+	limit, err := cmd.Flags().GetUint32(limitFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := cmd.Flags().GetUint32(offsetFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	pageToken, err := cmd.Flags().GetString(pageTokenFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := cmd.Flags().GetBool(countFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	return &paginator{
+		limit:     limit,
+		offset:    offset,
+		pageToken: pageToken,
+		count:     count,
+	}, nil
+}
+
+// PageSize returns the page size to request from the store. Zero lets the store pick its default.
+func (p *paginator) PageSize() uint32 {
+	if p.limit == 0 {
+		return 0
+	}
+
+	// The sum can exceed a uint32 for large offsets and limits, so it is computed in 64 bits before clamping.
+	remaining := uint64(p.offset-p.skipped) + uint64(p.limit-p.taken)
+	if remaining > maxPageSize {
+		return maxPageSize
+	}
+
+	return uint32(remaining)
+}
+
+// Take is called for every matching row and reports whether the row should be included in the output.
+func (p *paginator) Take() bool {
+	if p.skipped < p.offset {
+		p.skipped++
+		return false
+	}
+
+	if p.Done() {
+		return false
+	}
+
+	p.taken++
+	return true
+}
+
+// Done reports whether the limit has been reached and no more pages should be fetched.
+func (p *paginator) Done() bool {
+	return p.limit != 0 && p.taken >= p.limit
+}
+
+// Count returns the number of rows that have been taken so far.
+func (p *paginator) Count() uint32 {
+	return p.taken
+}
+
+// CountOnly reports whether only the number of results should be output.
+func (p *paginator) CountOnly() bool {
+	return p.count
+}
+
+// PageToken returns the page token to start listing from.
+func (p *paginator) PageToken() string {
+	return p.pageToken
+}
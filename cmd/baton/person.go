@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/conductorone/baton-sdk/pkg/logging"
+	v1 "github.com/conductorone/baton/pb/baton/v1"
+	"github.com/conductorone/baton/pkg/identity"
+	"github.com/conductorone/baton/pkg/output"
+	"github.com/spf13/cobra"
+)
+
+func personCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "person <email> [c1z files...]",
+		Short: "Show every account a person has across c1z files, along with the access each account holds",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  runPerson,
+	}
+
+	addMatchKeyFlag(cmd)
+	addOverridesFlag(cmd)
+	addManifestFlag(cmd)
+	addSyncIDFlag(cmd)
+
+	return cmd
+}
+
+// findPerson returns the identity the query refers to. The query is compared against identity keys and then against
+// account values for each match key in order, so an email address wins over a login that happens to look like one.
+func findPerson(identities []*identity.Identity, keys []string, query string) (*identity.Identity, error) {
+	q := strings.ToLower(strings.TrimSpace(query))
+	for _, ident := range identities {
+		if strings.ToLower(ident.Key) == q {
+			return ident, nil
+		}
+	}
+
+	for _, k := range keys {
+		var matches []*identity.Identity
+		for _, ident := range identities {
+			for _, a := range ident.Accounts {
+				if slices.Contains(a.Values(k), q) {
+					matches = append(matches, ident)
+					break
+				}
+			}
+		}
+
+		switch len(matches) {
+		case 0:
+			continue
+		case 1:
+			return matches[0], nil
+		default:
+			var people []string
+			for _, m := range matches {
+				people = append(people, m.Key)
+			}
+			return nil, fmt.Errorf("%s matches more than one person by %s: %s", query, k, strings.Join(people, ", "))
+		}
+	}
+
+	return nil, fmt.Errorf("no person found matching %s", query)
+}
+
+func runPerson(cmd *cobra.Command, args []string) error {
+	ctx, err := logging.Init(context.Background(), logging.WithLogFormat("console"), logging.WithLogLevel("error"))
+	if err != nil {
+		return err
+	}
+
+	c1zPaths, err := getC1ZPaths(cmd, args[1:])
+	if err != nil {
+		return err
+	}
+
+	outputFormat, err := cmd.Flags().GetString("output-format")
+	if err != nil {
+		return err
+	}
+	outputManager := output.NewManager(ctx, outputFormat)
+
+	syncID, err := cmd.Flags().GetString("sync-id")
+	if err != nil {
+		return err
+	}
+
+	opts, err := getCorrelateOptions(cmd)
+	if err != nil {
+		return err
+	}
+
+	sources, err := openC1ZSources(ctx, c1zPaths, syncID)
+	defer closeC1ZSources(ctx, sources)
+	if err != nil {
+		return err
+	}
+
+	sourcesByPath := make(map[string]*c1zSource)
+	var accounts []*identity.Account
+	for _, s := range sources {
+		sourcesByPath[s.path] = s
+
+		sourceAccounts, err := s.userAccounts(ctx)
+		if err != nil {
+			return err
+		}
+		accounts = append(accounts, sourceAccounts...)
+	}
+
+	person, err := findPerson(identity.CorrelateWith(accounts, opts), opts.Keys, args[0])
+	if err != nil {
+		return err
+	}
+
+	out := &v1.PersonOutput{
+		Files:       c1zPaths,
+		MatchKeys:   opts.Keys,
+		Key:         person.Key,
+		DisplayName: person.DisplayName(),
+		Emails:      person.Emails,
+	}
+	for _, a := range person.Accounts {
+		s := sourcesByPath[a.Source]
+
+		resourceType, err := s.sc.GetResourceType(ctx, a.Resource.Id.ResourceType)
+		if err != nil {
+			return err
+		}
+
+		account := &v1.PersonAccountOutput{
+			File:         s.path,
+			Account:      a.Resource,
+			ResourceType: resourceType,
+			Email:        a.PrimaryEmail(),
+			Login:        a.User.GetLogin(),
+			Status:       getUserStatus(ctx, a.User),
+			MatchedBy:    person.MatchedBy[a.Key()],
+		}
+		account.Entitlements, account.InheritedEntitlements, err = s.effectiveEntitlements(ctx, a.Resource.Id)
+		if err != nil {
+			return err
+		}
+		out.Accounts = append(out.Accounts, account)
+	}
+
+	err = outputManager.Output(ctx, out)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/conductorone/baton-sdk/pkg/dotc1z/manager"
 	"github.com/conductorone/baton-sdk/pkg/logging"
 	v1 "github.com/conductorone/baton/pb/baton/v1"
+	"github.com/conductorone/baton/pkg/expansion"
 	"github.com/conductorone/baton/pkg/output"
 	"github.com/conductorone/baton/pkg/storecache"
 	"github.com/spf13/cobra"
@@ -19,13 +20,20 @@ func principalsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "principals",
 		Short: "List principals",
-		RunE:  runPrincipals,
+		Long: `List principals.
+
+Principals are found by walking grants, so pagination is over grants rather than principals. --page-token resumes
+after the last grant that was read, and --offset, --limit and --count apply to the distinct principals seen since
+that token. A principal with grants on both sides of a page token is listed again when resuming from it.`,
+		RunE: runPrincipals,
 	}
 
 	addResourceFlag(cmd)
 	addResourceTypeFlag(cmd)
 	addEntitlementFlag(cmd)
 	addSyncIDFlag(cmd)
+	addPaginationFlags(cmd)
+	addExpandFlag(cmd)
 
 	cmd.MarkFlagsRequiredTogether(resourceTypeFlag, resourceFlag)
 	cmd.MarkFlagsMutuallyExclusive(resourceFlag, entitlementFlag)
@@ -35,12 +43,13 @@ func principalsCmd() *cobra.Command {
 	return cmd
 }
 
-func listPrincipalsForEntitlement(ctx context.Context, entitlementID string, sc *storecache.StoreCache, pageToken string) ([]*v2.Resource, string, error) {
+func listPrincipalsForEntitlement(ctx context.Context, entitlementID string, sc *storecache.StoreCache, pageSize uint32, pageToken string) ([]*v2.Resource, string, error) {
 	var ret []*v2.Resource
 
 	entitlement := &v2.Entitlement{Id: entitlementID}
 	req := &reader_v2.GrantsReaderServiceListGrantsForEntitlementRequest{
 		Entitlement: entitlement,
+		PageSize:    pageSize,
 		PageToken:   pageToken,
 	}
 	resp, err := sc.Store().ListGrantsForEntitlement(ctx, req)
@@ -59,7 +68,7 @@ func listPrincipalsForEntitlement(ctx context.Context, entitlementID string, sc
 	return ret, resp.NextPageToken, nil
 }
 
-func listPrincipalsForResource(ctx context.Context, cmd *cobra.Command, sc *storecache.StoreCache, pageToken string) ([]*v2.Resource, string, error) {
+func listPrincipalsForResource(ctx context.Context, cmd *cobra.Command, sc *storecache.StoreCache, pageSize uint32, pageToken string) ([]*v2.Resource, string, error) {
 	var ret []*v2.Resource
 
 	resourceTypeID, err := cmd.Flags().GetString(resourceTypeFlag)
@@ -80,6 +89,7 @@ func listPrincipalsForResource(ctx context.Context, cmd *cobra.Command, sc *stor
 	}}
 	req := &v2.GrantsServiceListGrantsRequest{
 		Resource:  resource,
+		PageSize:  pageSize,
 		PageToken: pageToken,
 	}
 	resp, err := sc.Store().ListGrants(ctx, req)
@@ -98,10 +108,11 @@ func listPrincipalsForResource(ctx context.Context, cmd *cobra.Command, sc *stor
 	return ret, resp.NextPageToken, nil
 }
 
-func listAllPrincipals(ctx context.Context, sc *storecache.StoreCache, pageToken string) ([]*v2.Resource, string, error) {
+func listAllPrincipals(ctx context.Context, sc *storecache.StoreCache, pageSize uint32, pageToken string) ([]*v2.Resource, string, error) {
 	var ret []*v2.Resource
 
 	req := &v2.GrantsServiceListGrantsRequest{
+		PageSize:  pageSize,
 		PageToken: pageToken,
 	}
 	resp, err := sc.Store().ListGrants(ctx, req)
@@ -120,6 +131,73 @@ func listAllPrincipals(ctx context.Context, sc *storecache.StoreCache, pageToken
 	return ret, resp.NextPageToken, nil
 }
 
+// listExpandedPrincipals returns every principal that effectively holds the entitlement, or any entitlement on the
+// resource, once grant expansion has been applied.
+func listExpandedPrincipals(ctx context.Context, cmd *cobra.Command, sc *storecache.StoreCache) ([]*v2.Resource, error) {
+	var entitlementIDs []string
+	if cmd.Flags().Changed(entitlementFlag) {
+		enID, err := cmd.Flags().GetString(entitlementFlag)
+		if err != nil {
+			return nil, err
+		}
+		entitlementIDs = append(entitlementIDs, enID)
+	} else {
+		resourceTypeID, err := cmd.Flags().GetString(resourceTypeFlag)
+		if err != nil {
+			return nil, err
+		}
+		resourceID, err := cmd.Flags().GetString(resourceFlag)
+		if err != nil {
+			return nil, err
+		}
+		if resourceTypeID == "" || resourceID == "" {
+			return nil, fmt.Errorf("--%s and --%s are required", resourceTypeFlag, resourceFlag)
+		}
+
+		resource := &v2.Resource{Id: &v2.ResourceId{
+			ResourceType: resourceTypeID,
+			Resource:     resourceID,
+		}}
+		pageToken := ""
+		for {
+			resp, err := sc.Store().ListEntitlements(ctx, &v2.EntitlementsServiceListEntitlementsRequest{
+				Resource:  resource,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			for _, en := range resp.List {
+				entitlementIDs = append(entitlementIDs, en.Id)
+			}
+
+			if resp.NextPageToken == "" {
+				break
+			}
+			pageToken = resp.NextPageToken
+		}
+	}
+
+	graph, err := expansion.Load(ctx, sc.Store())
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []*v2.Resource
+	for _, enID := range entitlementIDs {
+		for _, h := range graph.EffectiveHolders(enID) {
+			p, err := sc.GetResource(ctx, h.Principal)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, p)
+		}
+	}
+
+	return ret, nil
+}
+
 func getResourceIdString(p *v2.Resource) string {
 	return fmt.Sprintf("%s:%s", p.Id.ResourceType, p.Id.Resource)
 }
@@ -145,6 +223,11 @@ func runPrincipals(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	pager, err := newPaginator(cmd)
+	if err != nil {
+		return err
+	}
+
 	m, err := manager.New(ctx, c1zPath)
 	if err != nil {
 		return err
@@ -163,16 +246,33 @@ func runPrincipals(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	expand, err := cmd.Flags().GetBool(expandFlag)
+	if err != nil {
+		return err
+	}
+	if expand && !cmd.Flags().Changed(resourceFlag) && !cmd.Flags().Changed(entitlementFlag) {
+		return fmt.Errorf("--%s requires --%s or --%s", expandFlag, resourceFlag, entitlementFlag)
+	}
+	if expand && cmd.Flags().Changed(pageTokenFlag) {
+		return fmt.Errorf("--%s can't be combined with --%s, expanded principals are listed in a single page", pageTokenFlag, expandFlag)
+	}
+
 	sc := storecache.NewStoreCache(ctx, store)
 
+	// Principals are de-duplicated within a run only. The page token points into the grants, so a resumed run can list
+	// a principal that an earlier page already printed.
 	seenPrincipals := make(map[string]struct{})
 	var outputs []*v1.ResourceOutput
-	pageToken := ""
+	pageToken := pager.PageToken()
 	for {
 		var principals []*v2.Resource
 		switch {
+		case expand:
+			// Expanded holders are computed in memory, so there is only ever a single page.
+			principals, err = listExpandedPrincipals(ctx, cmd, sc)
+			pageToken = ""
 		case cmd.Flags().Changed(resourceFlag):
-			principals, pageToken, err = listPrincipalsForResource(ctx, cmd, sc, pageToken)
+			principals, pageToken, err = listPrincipalsForResource(ctx, cmd, sc, pager.PageSize(), pageToken)
 		case cmd.Flags().Changed(entitlementFlag):
 			var enID string
 			enID, err = cmd.Flags().GetString(entitlementFlag)
@@ -180,9 +280,9 @@ func runPrincipals(cmd *cobra.Command, args []string) error {
 				return err
 			}
 
-			principals, pageToken, err = listPrincipalsForEntitlement(ctx, enID, sc, pageToken)
+			principals, pageToken, err = listPrincipalsForEntitlement(ctx, enID, sc, pager.PageSize(), pageToken)
 		default:
-			principals, pageToken, err = listAllPrincipals(ctx, sc, pageToken)
+			principals, pageToken, err = listAllPrincipals(ctx, sc, pager.PageSize(), pageToken)
 		}
 		if err != nil {
 			return err
@@ -191,6 +291,11 @@ func runPrincipals(cmd *cobra.Command, args []string) error {
 		for _, p := range principals {
 			cacheKey := getResourceIdString(p)
 			if _, ok := seenPrincipals[cacheKey]; !ok {
+				seenPrincipals[cacheKey] = struct{}{}
+				if !pager.Take() || pager.CountOnly() {
+					continue
+				}
+
 				resourceType, err := sc.GetResourceType(ctx, p.Id.ResourceType)
 				if err != nil {
 					return err
@@ -209,16 +314,25 @@ func runPrincipals(cmd *cobra.Command, args []string) error {
 					ResourceType: resourceType,
 					Parent:       parent,
 				})
-				seenPrincipals[cacheKey] = struct{}{}
 			}
 		}
 
-		if pageToken == "" {
+		if pageToken == "" || pager.Done() {
 			break
 		}
 	}
 
-	err = outputManager.Output(ctx, &v1.ResourceListOutput{Resources: outputs})
+	if pager.CountOnly() {
+		return outputManager.Output(ctx, &v1.CountOutput{
+			Count:         pager.Count(),
+			NextPageToken: pageToken,
+		})
+	}
+
+	err = outputManager.Output(ctx, &v1.ResourceListOutput{
+		Resources:     outputs,
+		NextPageToken: pageToken,
+	})
 	if err != nil {
 		return err
 	}
@@ -4,15 +4,16 @@ import (
 	"context"
 
 	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
-	"github.com/conductorone/baton-sdk/pkg/annotations"
-	"github.com/conductorone/baton-sdk/pkg/dotc1z/manager"
 	"github.com/conductorone/baton-sdk/pkg/logging"
 	v1 "github.com/conductorone/baton/pb/baton/v1"
+	"github.com/conductorone/baton/pkg/identity"
 	"github.com/conductorone/baton/pkg/output"
-	"github.com/conductorone/baton/pkg/storecache"
 	"github.com/spf13/cobra"
 )
 
+// defaultCompareMatchKeys match principals by ID first, then by email, then by display name.
+var defaultCompareMatchKeys = []string{identity.KeyResourceID, identity.KeyEmail, identity.KeyDisplayName}
+
 func principalsCompareCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "compare",
@@ -24,10 +25,115 @@ func principalsCompareCmd() *cobra.Command {
 	addEntitlementFlag(cmd)
 	cmd.Flags().String("compare-file", "sync.c1z", "The path to the c1z file to compare with")
 	cmd.Flags().String("compare-entitlement", "", "The entitlement ID from the secondary C1Z to compare against")
+	addMatchKeyFlagWithDefault(cmd, defaultCompareMatchKeys)
 
 	return cmd
 }
 
+// entitlementPrincipals returns an account and an output for every distinct principal granted the entitlement. Each
+// output is enriched from the source the principal was read from.
+func entitlementPrincipals(ctx context.Context, s *c1zSource, entitlementID string) ([]*identity.Account, map[*identity.Account]*v1.ResourceOutput, error) {
+	var accounts []*identity.Account
+	outputs := make(map[*identity.Account]*v1.ResourceOutput)
+	seen := make(map[string]struct{})
+
+	pageToken := ""
+	for {
+		var principals []*v2.Resource
+		var err error
+		principals, pageToken, err = listPrincipalsForEntitlement(ctx, entitlementID, s.sc, 0, pageToken)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, p := range principals {
+			cacheKey := getResourceIdString(p)
+			if _, ok := seen[cacheKey]; ok {
+				continue
+			}
+			seen[cacheKey] = struct{}{}
+
+			resourceType, err := s.sc.GetResourceType(ctx, p.Id.ResourceType)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			var parent *v2.Resource
+			if p.ParentResourceId != nil {
+				parent, err = s.sc.GetResource(ctx, p.ParentResourceId)
+				if err != nil {
+					return nil, nil, err
+				}
+			}
+
+			a, err := identity.NewAccount(s.path, p)
+			if err != nil {
+				return nil, nil, err
+			}
+			accounts = append(accounts, a)
+			outputs[a] = &v1.ResourceOutput{
+				Resource:     p,
+				ResourceType: resourceType,
+				Parent:       parent,
+			}
+		}
+
+		if pageToken == "" {
+			break
+		}
+	}
+
+	return accounts, outputs, nil
+}
+
+// matchPrincipals pairs each base principal with a compared principal on the first match key that matches. It returns
+// the pairs, the base principals without a match and the compared principals that no base principal matched.
+func matchPrincipals(
+	matchKeys []string,
+	baseAccounts []*identity.Account,
+	baseOutputs map[*identity.Account]*v1.ResourceOutput,
+	diffAccounts []*identity.Account,
+	diffOutputs map[*identity.Account]*v1.ResourceOutput,
+) ([]*v1.PrincipalMatchOutput, []*v1.ResourceOutput, []*v1.ResourceOutput) {
+	var matched []*v1.PrincipalMatchOutput
+	var missing, extra []*v1.ResourceOutput
+
+	idx := identity.NewIndex(matchKeys, diffAccounts)
+	diffMatched := make(map[*identity.Account]struct{})
+	for _, a := range baseAccounts {
+		matches, matchKey := idx.Match(a)
+		if len(matches) == 0 {
+			missing = append(missing, baseOutputs[a])
+			continue
+		}
+
+		// Prefer a principal that has not been matched yet, so that several base principals with the same display name
+		// pair up with distinct compared principals where possible.
+		match := matches[0]
+		for _, m := range matches {
+			if _, ok := diffMatched[m]; !ok {
+				match = m
+				break
+			}
+		}
+		diffMatched[match] = struct{}{}
+
+		matched = append(matched, &v1.PrincipalMatchOutput{
+			Base:     baseOutputs[a],
+			Compared: diffOutputs[match],
+			MatchKey: matchKey,
+		})
+	}
+
+	for _, a := range diffAccounts {
+		if _, ok := diffMatched[a]; !ok {
+			extra = append(extra, diffOutputs[a])
+		}
+	}
+
+	return matched, missing, extra
+}
+
 func runPrincipalsCompare(cmd *cobra.Command, args []string) error {
 	ctx, err := logging.Init(context.Background(), logging.WithLogFormat("console"), logging.WithLogLevel("error"))
 	if err != nil {
@@ -44,159 +150,60 @@ func runPrincipalsCompare(cmd *cobra.Command, args []string) error {
 	}
 	outputManager := output.NewManager(ctx, outputFormat)
 
-	m, err := manager.New(ctx, c1zPath)
+	compareC1zPath, err := cmd.Flags().GetString("compare-file")
 	if err != nil {
 		return err
 	}
-	defer m.Close(ctx)
 
-	store, err := m.LoadC1Z(ctx)
+	baseEntitlementID, err := cmd.Flags().GetString(entitlementFlag)
 	if err != nil {
 		return err
 	}
 
-	sc := storecache.NewStoreCache(ctx, store)
-
-	compareC1zPath, err := cmd.Flags().GetString("compare-file")
+	diffEntitlementID, err := cmd.Flags().GetString("compare-entitlement")
 	if err != nil {
 		return err
 	}
 
-	mCompare, err := manager.New(ctx, compareC1zPath)
+	matchKeys, err := getMatchKeys(cmd)
 	if err != nil {
 		return err
 	}
-	defer m.Close(ctx)
 
-	storeCompare, err := mCompare.LoadC1Z(ctx)
+	base, err := openC1ZSource(ctx, c1zPath, "")
 	if err != nil {
 		return err
 	}
+	defer closeC1ZSources(ctx, []*c1zSource{base})
 
-	scDiff := storecache.NewStoreCache(ctx, storeCompare)
-
-	baseEntitlementID, err := cmd.Flags().GetString(entitlementFlag)
+	compare, err := openC1ZSource(ctx, compareC1zPath, "")
 	if err != nil {
 		return err
 	}
+	defer closeC1ZSources(ctx, []*c1zSource{compare})
 
-	diffEntitlementID, err := cmd.Flags().GetString("compare-entitlement")
+	baseAccounts, baseOutputs, err := entitlementPrincipals(ctx, base, baseEntitlementID)
 	if err != nil {
 		return err
 	}
 
-	outputs := &v1.PrincipalsCompareOutput{}
-
-	basePrincipals := make(map[string]*v1.ResourceOutput)
-	pageToken := ""
-	for {
-		var principals []*v2.Resource
-		principals, pageToken, err = listPrincipalsForEntitlement(ctx, baseEntitlementID, sc, pageToken)
-		if err != nil {
-			return err
-		}
-
-		for _, p := range principals {
-			cacheKey := getResourceIdString(p)
-			if _, ok := basePrincipals[cacheKey]; !ok {
-				resourceType, err := sc.GetResourceType(ctx, p.Id.ResourceType)
-				if err != nil {
-					return err
-				}
-
-				var parent *v2.Resource
-				if p.ParentResourceId != nil {
-					parent, err = sc.GetResource(ctx, p.ParentResourceId)
-					if err != nil {
-						return err
-					}
-				}
-
-				rOutput := &v1.ResourceOutput{
-					Resource:     p,
-					ResourceType: resourceType,
-					Parent:       parent,
-				}
-
-				outputs.Base = append(outputs.Base, rOutput)
-				basePrincipals[cacheKey] = rOutput
-			}
-		}
-
-		if pageToken == "" {
-			break
-		}
+	diffAccounts, diffOutputs, err := entitlementPrincipals(ctx, compare, diffEntitlementID)
+	if err != nil {
+		return err
 	}
 
-	diffPrincipals := make(map[string]*v1.ResourceOutput)
-	pageToken = ""
-	for {
-		var principals []*v2.Resource
-		principals, pageToken, err = listPrincipalsForEntitlement(ctx, diffEntitlementID, scDiff, pageToken)
-		if err != nil {
-			return err
-		}
-
-		for _, p := range principals {
-			cacheKey := getResourceIdString(p)
-			if _, ok := diffPrincipals[cacheKey]; !ok {
-				resourceType, err := sc.GetResourceType(ctx, p.Id.ResourceType)
-				if err != nil {
-					return err
-				}
-
-				var parent *v2.Resource
-				if p.ParentResourceId != nil {
-					parent, err = sc.GetResource(ctx, p.ParentResourceId)
-					if err != nil {
-						return err
-					}
-				}
-
-				rOutput := &v1.ResourceOutput{
-					Resource:     p,
-					ResourceType: resourceType,
-					Parent:       parent,
-				}
-
-				outputs.Compared = append(outputs.Compared, rOutput)
-				diffPrincipals[cacheKey] = rOutput
-			}
-		}
-
-		if pageToken == "" {
-			break
-		}
+	outputs := &v1.PrincipalsCompareOutput{
+		MatchKeys: matchKeys,
 	}
-
-	matched := make(map[string]string)
-	diffMatched := make(map[string]string)
-
-	for baseID, basePrincipal := range basePrincipals {
-		for diffID, diffPrincipal := range diffPrincipals {
-			match, err := comparePrincipal(basePrincipal.Resource, diffPrincipal.Resource)
-			if err != nil {
-				return err
-			}
-
-			if match {
-				matched[baseID] = diffID
-				diffMatched[diffID] = baseID
-				break
-			}
-		}
-
-		if _, ok := matched[baseID]; !ok {
-			outputs.Missing = append(outputs.Missing, basePrincipal)
-		}
+	for _, a := range baseAccounts {
+		outputs.Base = append(outputs.Base, baseOutputs[a])
 	}
-
-	for pID, diffPrincipal := range diffPrincipals {
-		if _, ok := diffMatched[pID]; !ok {
-			outputs.Extra = append(outputs.Extra, diffPrincipal)
-		}
+	for _, a := range diffAccounts {
+		outputs.Compared = append(outputs.Compared, diffOutputs[a])
 	}
 
+	outputs.Matched, outputs.Missing, outputs.Extra = matchPrincipals(matchKeys, baseAccounts, baseOutputs, diffAccounts, diffOutputs)
+
 	err = outputManager.Output(ctx, outputs)
 	if err != nil {
 		return err
@@ -204,48 +211,3 @@ func runPrincipalsCompare(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
-
-func comparePrincipal(base *v2.Resource, diff *v2.Resource) (bool, error) {
-	// Return true if these are the same object
-	if base.Id.ResourceType == diff.Id.ResourceType && base.Id.Resource == diff.Id.Resource {
-		return true, nil
-	}
-
-	baseAnnos := annotations.Annotations(base.Annotations)
-	diffAnnos := annotations.Annotations(diff.Annotations)
-
-	baseUserTrait := &v2.UserTrait{}
-	diffUserTrait := &v2.UserTrait{}
-
-	baseHasUserTrait, err := baseAnnos.Pick(baseUserTrait)
-	if err != nil {
-		return false, err
-	}
-
-	diffHasUserTrait, err := diffAnnos.Pick(diffUserTrait)
-	if err != nil {
-		return false, err
-	}
-
-	switch {
-	// Both resources have a user trait, try to compare email addresses
-	case baseHasUserTrait && diffHasUserTrait:
-		for _, baseEmail := range baseUserTrait.Emails {
-			for _, diffEmail := range diffUserTrait.Emails {
-				if baseEmail.Address == diffEmail.Address {
-					return true, nil
-				}
-			}
-		}
-
-	// If they either don't both have a user trait or are both missing it, we know they aren't the same.
-	case baseHasUserTrait != diffHasUserTrait:
-		return false, nil
-	}
-
-	if base.DisplayName == diff.DisplayName {
-		return true, nil
-	}
-
-	return false, nil
-}
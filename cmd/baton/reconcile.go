@@ -0,0 +1,232 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/conductorone/baton-sdk/pkg/logging"
+	v1 "github.com/conductorone/baton/pb/baton/v1"
+	"github.com/conductorone/baton/pkg/output"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
+	reader_v2 "github.com/conductorone/baton-sdk/pb/c1/reader/v2"
+)
+
+// reconcileMappings is the layout of a reconciliation mapping file. Relative file paths are resolved against the
+// mapping file's directory.
+//
+//	source_file: okta.c1z
+//	target_file: github.c1z
+//	mappings:
+//	  - name: Engineering admins are org owners
+//	    source: group:00g1eng-admins:member
+//	    target: org:acme:owner
+type reconcileMappings struct {
+	SourceFile string              `yaml:"source_file"`
+	TargetFile string              `yaml:"target_file"`
+	Mappings   []*reconcileMapping `yaml:"mappings"`
+}
+
+type reconcileMapping struct {
+	Name   string `yaml:"name"`
+	Source string `yaml:"source"`
+	Target string `yaml:"target"`
+}
+
+func loadReconcileMappings(mappingPath string) (*reconcileMappings, error) {
+	data, err := os.ReadFile(mappingPath)
+	if err != nil {
+		return nil, err
+	}
+
+	mappings := &reconcileMappings{}
+	err = yaml.Unmarshal(data, mappings)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", mappingPath, err)
+	}
+
+	if len(mappings.Mappings) == 0 {
+		return nil, fmt.Errorf("%s does not contain any mappings", mappingPath)
+	}
+
+	for i, m := range mappings.Mappings {
+		if m.Source == "" || m.Target == "" {
+			return nil, fmt.Errorf("mapping %d must set source and target", i+1)
+		}
+		if m.Name == "" {
+			m.Name = fmt.Sprintf("%s => %s", m.Source, m.Target)
+		}
+	}
+
+	for _, f := range []*string{&mappings.SourceFile, &mappings.TargetFile} {
+		if *f != "" && !filepath.IsAbs(*f) {
+			*f = filepath.Join(filepath.Dir(mappingPath), *f)
+		}
+	}
+
+	return mappings, nil
+}
+
+func reconcileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reconcile",
+		Short: "Compare the principals of mapped entitlement pairs across two c1z files, such as identity provider groups and the application roles they provision",
+		RunE:  runReconcile,
+	}
+
+	cmd.Flags().String("mapping", "mapping.yaml", "The path to the mapping file listing source and target entitlement pairs")
+	cmd.Flags().String("source-file", "", "The c1z file the source entitlements are in. Overrides source_file in the mapping file.")
+	cmd.Flags().String("target-file", "", "The c1z file the target entitlements are in. Overrides target_file in the mapping file.")
+	addMatchKeyFlag(cmd)
+
+	return cmd
+}
+
+// getMappedEntitlement returns the entitlement, or an error naming the mapping if the file does not contain it.
+func getMappedEntitlement(ctx context.Context, s *c1zSource, m *reconcileMapping, entitlementID string) (*v2.Entitlement, error) {
+	resp, err := s.store.GetEntitlement(ctx, &reader_v2.EntitlementsReaderServiceGetEntitlementRequest{
+		EntitlementId: entitlementID,
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	if resp.GetEntitlement() == nil {
+		return nil, fmt.Errorf("mapping %s: entitlement %s not found in %s", m.Name, entitlementID, s.path)
+	}
+
+	return resp.Entitlement, nil
+}
+
+func reconcileMappingOutput(
+	ctx context.Context,
+	source *c1zSource,
+	target *c1zSource,
+	matchKeys []string,
+	m *reconcileMapping,
+) (*v1.ReconcileMappingOutput, error) {
+	sourceEntitlement, err := getMappedEntitlement(ctx, source, m, m.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	targetEntitlement, err := getMappedEntitlement(ctx, target, m, m.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceAccounts, sourceOutputs, err := entitlementPrincipals(ctx, source, m.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	targetAccounts, targetOutputs, err := entitlementPrincipals(ctx, target, m.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &v1.ReconcileMappingOutput{
+		Name:              m.Name,
+		SourceEntitlement: sourceEntitlement,
+		TargetEntitlement: targetEntitlement,
+		SourcePrincipals:  uint32(len(sourceAccounts)),
+		TargetPrincipals:  uint32(len(targetAccounts)),
+	}
+
+	var matched []*v1.PrincipalMatchOutput
+	matched, ret.Missing, ret.Extra = matchPrincipals(matchKeys, sourceAccounts, sourceOutputs, targetAccounts, targetOutputs)
+	ret.Matched = uint32(len(matched))
+
+	return ret, nil
+}
+
+func runReconcile(cmd *cobra.Command, args []string) error {
+	ctx, err := logging.Init(context.Background(), logging.WithLogFormat("console"), logging.WithLogLevel("error"))
+	if err != nil {
+		return err
+	}
+
+	outputFormat, err := cmd.Flags().GetString("output-format")
+	if err != nil {
+		return err
+	}
+	outputManager := output.NewManager(ctx, outputFormat)
+
+	mappingPath, err := cmd.Flags().GetString("mapping")
+	if err != nil {
+		return err
+	}
+	if mappingPath == "" {
+		return errors.New("--mapping is required")
+	}
+
+	mappings, err := loadReconcileMappings(mappingPath)
+	if err != nil {
+		return err
+	}
+
+	sourcePath, err := cmd.Flags().GetString("source-file")
+	if err != nil {
+		return err
+	}
+	if sourcePath == "" {
+		sourcePath = mappings.SourceFile
+	}
+	if sourcePath == "" {
+		return fmt.Errorf("--source-file is required when %s does not set source_file", mappingPath)
+	}
+
+	targetPath, err := cmd.Flags().GetString("target-file")
+	if err != nil {
+		return err
+	}
+	if targetPath == "" {
+		targetPath = mappings.TargetFile
+	}
+	if targetPath == "" {
+		return fmt.Errorf("--target-file is required when %s does not set target_file", mappingPath)
+	}
+
+	matchKeys, err := getMatchKeys(cmd)
+	if err != nil {
+		return err
+	}
+
+	sources, err := openC1ZSources(ctx, []string{sourcePath, targetPath}, "")
+	defer closeC1ZSources(ctx, sources)
+	if err != nil {
+		return err
+	}
+	source, target := sources[0], sources[1]
+
+	report := &v1.ReconcileOutput{
+		SourceFile: sourcePath,
+		TargetFile: targetPath,
+		MatchKeys:  matchKeys,
+	}
+	for _, m := range mappings.Mappings {
+		mo, err := reconcileMappingOutput(ctx, source, target, matchKeys, m)
+		if err != nil {
+			return err
+		}
+		report.Mappings = append(report.Mappings, mo)
+
+		report.TotalMissing += uint32(len(mo.Missing))
+		report.TotalExtra += uint32(len(mo.Extra))
+		if len(mo.Missing) == 0 && len(mo.Extra) == 0 {
+			report.MappingsInSync++
+		}
+	}
+
+	err = outputManager.Output(ctx, report)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
+)
+
+const (
+	authStatusEnabled  = "Enabled"
+	authStatusDisabled = "Disabled"
+	authStatusUnknown  = "Unknown"
+)
+
+func reportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Generate access reports from one or more C1Z files",
+	}
+
+	cmd.AddCommand(reportPrivilegedCmd())
+	cmd.AddCommand(reportDormantCmd())
+	cmd.AddCommand(reportAuthPostureCmd())
+	cmd.AddCommand(reportSecretsCmd())
+	cmd.AddCommand(reportServiceAccountsCmd())
+	cmd.AddCommand(reportEntitlementUsageCmd())
+
+	return cmd
+}
+
+// getUserMFA returns "Enabled", "Disabled" or "Unknown" when the connector did not report an MFA status.
+func getUserMFA(ut *v2.UserTrait) string {
+	if ut.GetMfaStatus() == nil {
+		return authStatusUnknown
+	}
+
+	if ut.MfaStatus.MfaEnabled {
+		return authStatusEnabled
+	}
+
+	return authStatusDisabled
+}
+
+// getUserSSO returns "Enabled", "Disabled" or "Unknown" when the connector did not report an SSO status.
+func getUserSSO(ut *v2.UserTrait) string {
+	if ut.GetSsoStatus() == nil {
+		return authStatusUnknown
+	}
+
+	if ut.SsoStatus.SsoEnabled {
+		return authStatusEnabled
+	}
+
+	return authStatusDisabled
+}
+
+// listAllEntitlements calls fn for every entitlement in the store.
+func listAllEntitlements(ctx context.Context, s *c1zSource, fn func(en *v2.Entitlement) error) error {
+	pageToken := ""
+	for {
+		resp, err := s.store.ListEntitlements(ctx, &v2.EntitlementsServiceListEntitlementsRequest{PageToken: pageToken})
+		if err != nil {
+			return err
+		}
+
+		for _, en := range resp.List {
+			err = fn(en)
+			if err != nil {
+				return err
+			}
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+
+	"github.com/conductorone/baton-sdk/pkg/logging"
+	v1 "github.com/conductorone/baton/pb/baton/v1"
+	"github.com/conductorone/baton/pkg/output"
+	"github.com/spf13/cobra"
+
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
+)
+
+func reportAuthPostureCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auth-posture [c1z files...]",
+		Short: "Break down users' MFA and SSO status, and list privileged users without MFA",
+		RunE:  runReportAuthPosture,
+	}
+
+	cmd.Flags().Bool("include-disabled", false, "Include users that are disabled or deleted")
+	cmd.Flags().Bool("include-unknown-mfa", false, "Also list privileged users whose MFA status was not reported by the connector")
+	addSyncIDFlag(cmd)
+	addPrivilegedFlags(cmd)
+
+	return cmd
+}
+
+func addAuthPosture(b *v1.AuthPostureBreakdown, ut *v2.UserTrait) {
+	b.Users++
+
+	switch getUserMFA(ut) {
+	case authStatusEnabled:
+		b.MfaEnabled++
+	case authStatusDisabled:
+		b.MfaDisabled++
+	default:
+		b.MfaUnknown++
+	}
+
+	switch getUserSSO(ut) {
+	case authStatusEnabled:
+		b.SsoEnabled++
+	case authStatusDisabled:
+		b.SsoDisabled++
+	default:
+		b.SsoUnknown++
+	}
+}
+
+func runReportAuthPosture(cmd *cobra.Command, args []string) error {
+	ctx, err := logging.Init(context.Background(), logging.WithLogFormat("console"), logging.WithLogLevel("error"))
+	if err != nil {
+		return err
+	}
+
+	c1zPaths, err := getC1ZPaths(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	outputFormat, err := cmd.Flags().GetString("output-format")
+	if err != nil {
+		return err
+	}
+	outputManager := output.NewManager(ctx, outputFormat)
+
+	syncID, err := cmd.Flags().GetString("sync-id")
+	if err != nil {
+		return err
+	}
+
+	includeDisabled, err := cmd.Flags().GetBool("include-disabled")
+	if err != nil {
+		return err
+	}
+
+	includeUnknownMFA, err := cmd.Flags().GetBool("include-unknown-mfa")
+	if err != nil {
+		return err
+	}
+
+	classifier, err := getPrivilegedClassifier(cmd)
+	if err != nil {
+		return err
+	}
+
+	sources, err := openC1ZSources(ctx, c1zPaths, syncID)
+	defer closeC1ZSources(ctx, sources)
+	if err != nil {
+		return err
+	}
+
+	report := &v1.AuthPostureReportOutput{
+		Files: c1zPaths,
+		Total: &v1.AuthPostureBreakdown{},
+	}
+	for _, s := range sources {
+		graph, err := s.Graph(ctx)
+		if err != nil {
+			return err
+		}
+
+		accounts, err := s.userAccounts(ctx)
+		if err != nil {
+			return err
+		}
+
+		byFile := &v1.AuthPostureBreakdown{File: s.path}
+		byResourceType := make(map[string]*v1.AuthPostureBreakdown)
+		var resourceTypeIDs []string
+
+		for _, a := range accounts {
+			if a.User == nil {
+				continue
+			}
+
+			status := a.User.GetStatus().GetStatus()
+			if !includeDisabled && (status == v2.UserTrait_Status_STATUS_DISABLED || status == v2.UserTrait_Status_STATUS_DELETED) {
+				continue
+			}
+
+			rtID := a.Resource.Id.ResourceType
+			b, ok := byResourceType[rtID]
+			if !ok {
+				rt, err := s.sc.GetResourceType(ctx, rtID)
+				if err != nil {
+					return err
+				}
+				b = &v1.AuthPostureBreakdown{File: s.path, ResourceType: rt}
+				byResourceType[rtID] = b
+				resourceTypeIDs = append(resourceTypeIDs, rtID)
+			}
+
+			addAuthPosture(b, a.User)
+			addAuthPosture(byFile, a.User)
+			addAuthPosture(report.Total, a.User)
+
+			mfa := getUserMFA(a.User)
+			if mfa == authStatusEnabled || (mfa == authStatusUnknown && !includeUnknownMFA) {
+				continue
+			}
+
+			var privilegedEntitlements []*v2.Entitlement
+			for _, access := range graph.EffectiveAccess(a.Resource.Id) {
+				en, err := s.sc.GetEntitlement(ctx, access.EntitlementID)
+				if err != nil {
+					return err
+				}
+
+				ok, err := classifier.IsPrivileged(ctx, s.sc, en)
+				if err != nil {
+					return err
+				}
+				if ok {
+					privilegedEntitlements = append(privilegedEntitlements, en)
+				}
+			}
+			if len(privilegedEntitlements) == 0 {
+				continue
+			}
+
+			report.PrivilegedWithoutMfa = append(report.PrivilegedWithoutMfa, &v1.MfaRiskUserOutput{
+				File:                   s.path,
+				User:                   a.Resource,
+				ResourceType:           b.ResourceType,
+				Email:                  a.PrimaryEmail(),
+				Status:                 getUserStatus(ctx, a.User),
+				Mfa:                    mfa,
+				PrivilegedEntitlements: privilegedEntitlements,
+			})
+		}
+
+		report.ByFile = append(report.ByFile, byFile)
+		for _, rtID := range resourceTypeIDs {
+			report.ByResourceType = append(report.ByResourceType, byResourceType[rtID])
+		}
+	}
+
+	err = outputManager.Output(ctx, report)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
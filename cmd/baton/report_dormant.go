@@ -0,0 +1,210 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/conductorone/baton-sdk/pkg/logging"
+	v1 "github.com/conductorone/baton/pb/baton/v1"
+	"github.com/conductorone/baton/pkg/identity"
+	"github.com/conductorone/baton/pkg/output"
+	"github.com/conductorone/baton/pkg/privileged"
+	"github.com/spf13/cobra"
+
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
+)
+
+func reportDormantCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dormant [c1z files...]",
+		Short: "List users who have not logged in recently, along with the access they still hold",
+		RunE:  runReportDormant,
+	}
+
+	cmd.Flags().Uint32("days", 90, "The number of days without a login after which a user is considered dormant")
+	cmd.Flags().Bool("include-disabled", false, "Include users that are already disabled or deleted")
+	addSyncIDFlag(cmd)
+	addPrivilegedFlags(cmd)
+
+	return cmd
+}
+
+func daysSince(now time.Time, t time.Time) uint32 {
+	if t.After(now) {
+		return 0
+	}
+
+	return uint32(now.Sub(t).Hours() / 24)
+}
+
+func dormantUserOutput(
+	ctx context.Context,
+	s *c1zSource,
+	classifier *privileged.Classifier,
+	a *identity.Account,
+) (*v1.DormantUserOutput, error) {
+	graph, err := s.Graph(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceType, err := s.sc.GetResourceType(ctx, a.Resource.Id.ResourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &v1.DormantUserOutput{
+		File:         s.path,
+		User:         a.Resource,
+		ResourceType: resourceType,
+		Email:        a.PrimaryEmail(),
+		Status:       getUserStatus(ctx, a.User),
+		LastLogin:    a.User.LastLogin,
+		CreatedAt:    a.User.CreatedAt,
+	}
+
+	for _, access := range graph.EffectiveAccess(a.Resource.Id) {
+		en, err := s.sc.GetEntitlement(ctx, access.EntitlementID)
+		if err != nil {
+			return nil, err
+		}
+		ret.Entitlements = append(ret.Entitlements, en)
+
+		ok, err := classifier.IsPrivileged(ctx, s.sc, en)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			ret.PrivilegedEntitlements = append(ret.PrivilegedEntitlements, en)
+		}
+	}
+
+	return ret, nil
+}
+
+func runReportDormant(cmd *cobra.Command, args []string) error {
+	ctx, err := logging.Init(context.Background(), logging.WithLogFormat("console"), logging.WithLogLevel("error"))
+	if err != nil {
+		return err
+	}
+
+	c1zPaths, err := getC1ZPaths(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	outputFormat, err := cmd.Flags().GetString("output-format")
+	if err != nil {
+		return err
+	}
+	outputManager := output.NewManager(ctx, outputFormat)
+
+	syncID, err := cmd.Flags().GetString("sync-id")
+	if err != nil {
+		return err
+	}
+
+	days, err := cmd.Flags().GetUint32("days")
+	if err != nil {
+		return err
+	}
+
+	includeDisabled, err := cmd.Flags().GetBool("include-disabled")
+	if err != nil {
+		return err
+	}
+
+	classifier, err := getPrivilegedClassifier(cmd)
+	if err != nil {
+		return err
+	}
+
+	sources, err := openC1ZSources(ctx, c1zPaths, syncID)
+	defer closeC1ZSources(ctx, sources)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	threshold := now.AddDate(0, 0, -int(days))
+
+	report := &v1.DormantReportOutput{
+		Files: c1zPaths,
+		Days:  days,
+	}
+	for _, s := range sources {
+		accounts, err := s.userAccounts(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, a := range accounts {
+			if a.User == nil {
+				continue
+			}
+
+			status := a.User.GetStatus().GetStatus()
+			if !includeDisabled && (status == v2.UserTrait_Status_STATUS_DISABLED || status == v2.UserTrait_Status_STATUS_DELETED) {
+				continue
+			}
+
+			lastLogin := a.User.GetLastLogin()
+			createdAt := a.User.GetCreatedAt()
+
+			switch {
+			case lastLogin != nil:
+				if !lastLogin.AsTime().Before(threshold) {
+					continue
+				}
+
+				u, err := dormantUserOutput(ctx, s, classifier, a)
+				if err != nil {
+					return err
+				}
+				u.DaysInactive = daysSince(now, lastLogin.AsTime())
+				report.Dormant = append(report.Dormant, u)
+
+			case createdAt != nil && !createdAt.AsTime().Before(threshold):
+				// Recently created users have not had a chance to log in yet, so they are reported separately.
+				u, err := dormantUserOutput(ctx, s, classifier, a)
+				if err != nil {
+					return err
+				}
+				u.NeverLoggedIn = true
+				u.DaysInactive = daysSince(now, createdAt.AsTime())
+				report.NewNeverLoggedIn = append(report.NewNeverLoggedIn, u)
+
+			default:
+				u, err := dormantUserOutput(ctx, s, classifier, a)
+				if err != nil {
+					return err
+				}
+				u.NeverLoggedIn = true
+				if createdAt != nil {
+					u.DaysInactive = daysSince(now, createdAt.AsTime())
+				}
+				report.Dormant = append(report.Dormant, u)
+			}
+		}
+	}
+
+	for _, users := range [][]*v1.DormantUserOutput{report.Dormant, report.NewNeverLoggedIn} {
+		sort.SliceStable(users, func(i, j int) bool {
+			if len(users[i].PrivilegedEntitlements) != len(users[j].PrivilegedEntitlements) {
+				return len(users[i].PrivilegedEntitlements) > len(users[j].PrivilegedEntitlements)
+			}
+			if len(users[i].Entitlements) != len(users[j].Entitlements) {
+				return len(users[i].Entitlements) > len(users[j].Entitlements)
+			}
+			return users[i].DaysInactive > users[j].DaysInactive
+		})
+	}
+
+	err = outputManager.Output(ctx, report)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,260 @@
+package main
+
+import (
+	"context"
+	"sort"
+
+	"github.com/conductorone/baton-sdk/pkg/logging"
+	v1 "github.com/conductorone/baton/pb/baton/v1"
+	"github.com/conductorone/baton/pkg/output"
+	"github.com/spf13/cobra"
+
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
+)
+
+func reportEntitlementUsageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "entitlement-usage [c1z files...]",
+		Short: "Show how many principals hold each entitlement, and list unused, single-holder and most-granted entitlements",
+		RunE:  runReportEntitlementUsage,
+	}
+
+	cmd.Flags().Uint32("top", 20, "The number of most-granted entitlements to list")
+	cmd.Flags().Bool("all", false, "List the usage of every entitlement")
+	addSyncIDFlag(cmd)
+
+	return cmd
+}
+
+// holderCounts counts the principals holding an entitlement by principal resource type.
+type holderCounts struct {
+	direct    map[string]uint32
+	effective map[string]uint32
+}
+
+// usageCounts holds the holder counts for every entitlement in a source.
+type usageCounts map[string]*holderCounts
+
+func (u usageCounts) get(entitlementID string) *holderCounts {
+	counts, ok := u[entitlementID]
+	if !ok {
+		counts = &holderCounts{
+			direct:    make(map[string]uint32),
+			effective: make(map[string]uint32),
+		}
+		u[entitlementID] = counts
+	}
+
+	return counts
+}
+
+// countHolders counts the direct and effective holders of every entitlement from the expansion graph, so the grants
+// are only read once. A principal can hold the same entitlement through more than one grant, but has a single access
+// for it, so principals are only counted once.
+func countHolders(ctx context.Context, s *c1zSource) (usageCounts, error) {
+	graph, err := s.Graph(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make(usageCounts)
+	for _, principal := range graph.Principals() {
+		for _, access := range graph.EffectiveAccess(principal) {
+			counts := ret.get(access.EntitlementID)
+			if access.Direct {
+				counts.direct[principal.ResourceType]++
+			}
+			counts.effective[principal.ResourceType]++
+		}
+	}
+
+	return ret, nil
+}
+
+// usageLookup resolves the resources and resource types referenced by the entitlements of a source. Everything is
+// loaded up front so that building the report does not need a store lookup for every entitlement.
+type usageLookup struct {
+	s             *c1zSource
+	resources     map[string]*v2.Resource
+	resourceTypes map[string]*v2.ResourceType
+}
+
+func newUsageLookup(ctx context.Context, s *c1zSource) (*usageLookup, error) {
+	resources, _, err := fetchResources(ctx, s.store)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceTypes, err := fetchResourceTypes(ctx, s.store)
+	if err != nil {
+		return nil, err
+	}
+
+	return &usageLookup{
+		s:             s,
+		resources:     resources,
+		resourceTypes: resourceTypes,
+	}, nil
+}
+
+// resource falls back to the store cache, which records a placeholder, for resources that were not listed.
+func (l *usageLookup) resource(ctx context.Context, id *v2.ResourceId) (*v2.Resource, error) {
+	if r, ok := l.resources[fmtResourceID(id)]; ok {
+		return r, nil
+	}
+
+	return l.s.sc.GetResource(ctx, id)
+}
+
+func (l *usageLookup) resourceType(ctx context.Context, id string) (*v2.ResourceType, error) {
+	if rt, ok := l.resourceTypes[id]; ok {
+		return rt, nil
+	}
+
+	return l.s.sc.GetResourceType(ctx, id)
+}
+
+func entitlementUsageOutput(ctx context.Context, l *usageLookup, en *v2.Entitlement, counts *holderCounts) (*v1.EntitlementUsageOutput, error) {
+	ret := &v1.EntitlementUsageOutput{
+		File:        l.s.path,
+		Entitlement: en,
+	}
+
+	if en.GetResource().GetId() != nil {
+		var err error
+		ret.Resource, err = l.resource(ctx, en.Resource.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		ret.ResourceType, err = l.resourceType(ctx, en.Resource.Id.ResourceType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if counts == nil {
+		return ret, nil
+	}
+
+	var principalTypes []string
+	for rt := range counts.effective {
+		principalTypes = append(principalTypes, rt)
+	}
+	sort.Strings(principalTypes)
+
+	for _, rt := range principalTypes {
+		resourceType, err := l.resourceType(ctx, rt)
+		if err != nil {
+			return nil, err
+		}
+
+		ret.DirectHolders += counts.direct[rt]
+		ret.EffectiveHolders += counts.effective[rt]
+		ret.ByPrincipalType = append(ret.ByPrincipalType, &v1.PrincipalTypeCount{
+			ResourceType: resourceType,
+			Direct:       counts.direct[rt],
+			Effective:    counts.effective[rt],
+		})
+	}
+
+	return ret, nil
+}
+
+func runReportEntitlementUsage(cmd *cobra.Command, args []string) error {
+	ctx, err := logging.Init(context.Background(), logging.WithLogFormat("console"), logging.WithLogLevel("error"))
+	if err != nil {
+		return err
+	}
+
+	c1zPaths, err := getC1ZPaths(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	outputFormat, err := cmd.Flags().GetString("output-format")
+	if err != nil {
+		return err
+	}
+	outputManager := output.NewManager(ctx, outputFormat)
+
+	syncID, err := cmd.Flags().GetString("sync-id")
+	if err != nil {
+		return err
+	}
+
+	top, err := cmd.Flags().GetUint32("top")
+	if err != nil {
+		return err
+	}
+
+	all, err := cmd.Flags().GetBool("all")
+	if err != nil {
+		return err
+	}
+
+	sources, err := openC1ZSources(ctx, c1zPaths, syncID)
+	defer closeC1ZSources(ctx, sources)
+	if err != nil {
+		return err
+	}
+
+	report := &v1.EntitlementUsageReportOutput{Files: c1zPaths}
+	var usage []*v1.EntitlementUsageOutput
+	for _, s := range sources {
+		counts, err := countHolders(ctx, s)
+		if err != nil {
+			return err
+		}
+
+		lookup, err := newUsageLookup(ctx, s)
+		if err != nil {
+			return err
+		}
+
+		err = listAllEntitlements(ctx, s, func(en *v2.Entitlement) error {
+			u, err := entitlementUsageOutput(ctx, lookup, en, counts[en.Id])
+			if err != nil {
+				return err
+			}
+			report.Entitlements++
+			usage = append(usage, u)
+
+			switch u.EffectiveHolders {
+			case 0:
+				report.Unused = append(report.Unused, u)
+			case 1:
+				report.SingleHolder = append(report.SingleHolder, u)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	sort.SliceStable(usage, func(i, j int) bool {
+		if usage[i].EffectiveHolders != usage[j].EffectiveHolders {
+			return usage[i].EffectiveHolders > usage[j].EffectiveHolders
+		}
+		return usage[i].DirectHolders > usage[j].DirectHolders
+	})
+
+	for _, u := range usage {
+		if uint32(len(report.MostGranted)) >= top || u.EffectiveHolders == 0 {
+			break
+		}
+		report.MostGranted = append(report.MostGranted, u)
+	}
+	if all {
+		report.All = usage
+	}
+
+	err = outputManager.Output(ctx, report)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+
+	"github.com/conductorone/baton-sdk/pkg/logging"
+	v1 "github.com/conductorone/baton/pb/baton/v1"
+	"github.com/conductorone/baton/pkg/identity"
+	"github.com/conductorone/baton/pkg/output"
+	"github.com/spf13/cobra"
+
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
+)
+
+func reportPrivilegedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "privileged [c1z files...]",
+		Short: "List everyone holding privileged entitlements, directly or through groups and roles",
+		RunE:  runReportPrivileged,
+	}
+
+	addSyncIDFlag(cmd)
+	addPrivilegedFlags(cmd)
+
+	return cmd
+}
+
+func runReportPrivileged(cmd *cobra.Command, args []string) error {
+	ctx, err := logging.Init(context.Background(), logging.WithLogFormat("console"), logging.WithLogLevel("error"))
+	if err != nil {
+		return err
+	}
+
+	c1zPaths, err := getC1ZPaths(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	outputFormat, err := cmd.Flags().GetString("output-format")
+	if err != nil {
+		return err
+	}
+	outputManager := output.NewManager(ctx, outputFormat)
+
+	syncID, err := cmd.Flags().GetString("sync-id")
+	if err != nil {
+		return err
+	}
+
+	classifier, err := getPrivilegedClassifier(cmd)
+	if err != nil {
+		return err
+	}
+
+	sources, err := openC1ZSources(ctx, c1zPaths, syncID)
+	defer closeC1ZSources(ctx, sources)
+	if err != nil {
+		return err
+	}
+
+	report := &v1.PrivilegedReportOutput{Files: c1zPaths}
+	for _, s := range sources {
+		graph, err := s.Graph(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = listAllEntitlements(ctx, s, func(en *v2.Entitlement) error {
+			ok, reason, err := classifier.Classify(ctx, s.sc, en)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return nil
+			}
+			report.PrivilegedEntitlements++
+
+			var resource *v2.Resource
+			var resourceType *v2.ResourceType
+			if en.GetResource().GetId() != nil {
+				resource, err = s.sc.GetResource(ctx, en.Resource.Id)
+				if err != nil {
+					return err
+				}
+
+				resourceType, err = s.sc.GetResourceType(ctx, en.Resource.Id.ResourceType)
+				if err != nil {
+					return err
+				}
+			}
+
+			for _, h := range graph.EffectiveHolders(en.Id) {
+				principal, err := s.sc.GetResource(ctx, h.Principal)
+				if err != nil {
+					return err
+				}
+
+				principalType, err := s.sc.GetResourceType(ctx, h.Principal.ResourceType)
+				if err != nil {
+					return err
+				}
+
+				access := &v1.PrivilegedAccessOutput{
+					File:          s.path,
+					Entitlement:   en,
+					Resource:      resource,
+					ResourceType:  resourceType,
+					Principal:     principal,
+					PrincipalType: principalType,
+					Direct:        h.Access.Direct,
+					Reason:        reason,
+				}
+
+				a, err := identity.NewAccount(s.path, principal)
+				if err != nil {
+					return err
+				}
+				if a.User != nil {
+					access.Email = a.PrimaryEmail()
+					access.Status = getUserStatus(ctx, a.User)
+					access.Mfa = getUserMFA(a.User)
+				}
+
+				report.Access = append(report.Access, access)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = outputManager.Output(ctx, report)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
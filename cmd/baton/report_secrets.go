@@ -0,0 +1,214 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/conductorone/baton-sdk/pkg/annotations"
+	"github.com/conductorone/baton-sdk/pkg/logging"
+	v1 "github.com/conductorone/baton/pb/baton/v1"
+	"github.com/conductorone/baton/pkg/identity"
+	"github.com/conductorone/baton/pkg/output"
+	"github.com/spf13/cobra"
+
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
+)
+
+func reportSecretsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "secrets [c1z files...]",
+		Short: "List API keys, tokens and other secrets with their owners, expiry and last use",
+		RunE:  runReportSecrets,
+	}
+
+	cmd.Flags().Uint32("expiring-days", 30, "The number of days before expiry at which a secret is flagged as expiring soon")
+	cmd.Flags().Bool("flagged-only", false, "Only list secrets that are expired, expiring soon, never used or whose owner is deleted")
+	addSyncIDFlag(cmd)
+
+	return cmd
+}
+
+func getSecretTrait(r *v2.Resource) (*v2.SecretTrait, error) {
+	st := &v2.SecretTrait{}
+	annos := annotations.Annotations(r.Annotations)
+	ok, err := annos.Pick(st)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	return st, nil
+}
+
+// secretIdentity returns the identity a secret authenticates as, falling back to its creator.
+func secretIdentity(st *v2.SecretTrait) *v2.ResourceId {
+	if st.GetIdentityId() != nil {
+		return st.IdentityId
+	}
+
+	return st.GetCreatedById()
+}
+
+func secretOutput(ctx context.Context, s *c1zSource, r *v2.Resource, st *v2.SecretTrait, now time.Time, expiringDays uint32) (*v1.SecretOutput, error) {
+	resourceType, err := s.sc.GetResourceType(ctx, r.Id.ResourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &v1.SecretOutput{
+		File:         s.path,
+		Secret:       r,
+		ResourceType: resourceType,
+		CreatedAt:    st.GetCreatedAt(),
+		ExpiresAt:    st.GetExpiresAt(),
+		LastUsedAt:   st.GetLastUsedAt(),
+		NeverUsed:    st.GetLastUsedAt() == nil,
+	}
+
+	if expiresAt := st.GetExpiresAt(); expiresAt != nil {
+		ret.Expired = !expiresAt.AsTime().After(now)
+		ret.ExpiringSoon = !ret.Expired && expiresAt.AsTime().Before(now.AddDate(0, 0, int(expiringDays)))
+	}
+
+	if st.GetCreatedById() != nil {
+		ret.CreatedBy, err = s.sc.GetResource(ctx, st.CreatedById)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ownerID := secretIdentity(st)
+	if ownerID == nil {
+		return ret, nil
+	}
+
+	exists, err := s.sc.ResourceExists(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		ret.OwnerDeleted = true
+		return ret, nil
+	}
+
+	owner, err := s.sc.GetResource(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := identity.NewAccount(s.path, owner)
+	if err != nil {
+		return nil, err
+	}
+	if a.User != nil {
+		ret.IdentityStatus = getUserStatus(ctx, a.User)
+		ret.OwnerDeleted = a.User.GetStatus().GetStatus() == v2.UserTrait_Status_STATUS_DELETED
+	}
+
+	if st.GetIdentityId() == nil {
+		return ret, nil
+	}
+
+	ret.Identity = owner
+	ret.IdentityResourceType, err = s.sc.GetResourceType(ctx, owner.Id.ResourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	ret.IdentityEntitlements, ret.IdentityInheritedEntitlements, err = s.effectiveEntitlements(ctx, owner.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func runReportSecrets(cmd *cobra.Command, args []string) error {
+	ctx, err := logging.Init(context.Background(), logging.WithLogFormat("console"), logging.WithLogLevel("error"))
+	if err != nil {
+		return err
+	}
+
+	c1zPaths, err := getC1ZPaths(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	outputFormat, err := cmd.Flags().GetString("output-format")
+	if err != nil {
+		return err
+	}
+	outputManager := output.NewManager(ctx, outputFormat)
+
+	syncID, err := cmd.Flags().GetString("sync-id")
+	if err != nil {
+		return err
+	}
+
+	expiringDays, err := cmd.Flags().GetUint32("expiring-days")
+	if err != nil {
+		return err
+	}
+
+	flaggedOnly, err := cmd.Flags().GetBool("flagged-only")
+	if err != nil {
+		return err
+	}
+
+	sources, err := openC1ZSources(ctx, c1zPaths, syncID)
+	defer closeC1ZSources(ctx, sources)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	report := &v1.SecretsReportOutput{
+		Files:        c1zPaths,
+		ExpiringDays: expiringDays,
+	}
+	for _, s := range sources {
+		err = s.resourcesWithTrait(ctx, v2.ResourceType_TRAIT_SECRET, func(r *v2.Resource) error {
+			st, err := getSecretTrait(r)
+			if err != nil {
+				return err
+			}
+			if st == nil {
+				st = &v2.SecretTrait{}
+			}
+
+			secret, err := secretOutput(ctx, s, r, st, now, expiringDays)
+			if err != nil {
+				return err
+			}
+
+			if flaggedOnly && !secret.Expired && !secret.ExpiringSoon && !secret.NeverUsed && !secret.OwnerDeleted {
+				return nil
+			}
+			report.Secrets = append(report.Secrets, secret)
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Secrets expiring soonest come first, followed by those without an expiry.
+	sort.SliceStable(report.Secrets, func(i, j int) bool {
+		ei, ej := report.Secrets[i].ExpiresAt, report.Secrets[j].ExpiresAt
+		if ei == nil || ej == nil {
+			return ei != nil
+		}
+		return ei.AsTime().Before(ej.AsTime())
+	})
+
+	err = outputManager.Output(ctx, report)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
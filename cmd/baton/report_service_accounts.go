@@ -0,0 +1,168 @@
+package main
+
+import (
+	"context"
+	"sort"
+
+	"github.com/conductorone/baton-sdk/pkg/logging"
+	v1 "github.com/conductorone/baton/pb/baton/v1"
+	"github.com/conductorone/baton/pkg/expansion"
+	"github.com/conductorone/baton/pkg/output"
+	"github.com/spf13/cobra"
+
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
+)
+
+func reportServiceAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "service-accounts [c1z files...]",
+		Short: "List service and system accounts with their secrets and the access they hold",
+		RunE:  runReportServiceAccounts,
+	}
+
+	cmd.Flags().Bool("include-disabled", false, "Include accounts that are already disabled or deleted")
+	addSyncIDFlag(cmd)
+	addPrivilegedFlags(cmd)
+
+	return cmd
+}
+
+// secretsByIdentity returns the source's secrets keyed by the resource key of the identity they authenticate as.
+func secretsByIdentity(ctx context.Context, s *c1zSource) (map[string][]*v2.Resource, error) {
+	ret := make(map[string][]*v2.Resource)
+	err := s.resourcesWithTrait(ctx, v2.ResourceType_TRAIT_SECRET, func(r *v2.Resource) error {
+		st, err := getSecretTrait(r)
+		if err != nil {
+			return err
+		}
+
+		identityID := st.GetIdentityId()
+		if identityID == nil {
+			return nil
+		}
+
+		key := expansion.ResourceKey(identityID)
+		ret[key] = append(ret[key], r)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func runReportServiceAccounts(cmd *cobra.Command, args []string) error {
+	ctx, err := logging.Init(context.Background(), logging.WithLogFormat("console"), logging.WithLogLevel("error"))
+	if err != nil {
+		return err
+	}
+
+	c1zPaths, err := getC1ZPaths(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	outputFormat, err := cmd.Flags().GetString("output-format")
+	if err != nil {
+		return err
+	}
+	outputManager := output.NewManager(ctx, outputFormat)
+
+	syncID, err := cmd.Flags().GetString("sync-id")
+	if err != nil {
+		return err
+	}
+
+	includeDisabled, err := cmd.Flags().GetBool("include-disabled")
+	if err != nil {
+		return err
+	}
+
+	classifier, err := getPrivilegedClassifier(cmd)
+	if err != nil {
+		return err
+	}
+
+	sources, err := openC1ZSources(ctx, c1zPaths, syncID)
+	defer closeC1ZSources(ctx, sources)
+	if err != nil {
+		return err
+	}
+
+	report := &v1.ServiceAccountsReportOutput{Files: c1zPaths}
+	for _, s := range sources {
+		secrets, err := secretsByIdentity(ctx, s)
+		if err != nil {
+			return err
+		}
+
+		accounts, err := s.userAccounts(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, a := range accounts {
+			if a.User == nil {
+				continue
+			}
+
+			accountType := a.User.GetAccountType()
+			if accountType != v2.UserTrait_ACCOUNT_TYPE_SERVICE && accountType != v2.UserTrait_ACCOUNT_TYPE_SYSTEM {
+				continue
+			}
+			if !includeDisabled && isUserInactive(a.User) {
+				continue
+			}
+
+			resourceType, err := s.sc.GetResourceType(ctx, a.Resource.Id.ResourceType)
+			if err != nil {
+				return err
+			}
+
+			sa := &v1.ServiceAccountOutput{
+				File:         s.path,
+				Account:      a.Resource,
+				ResourceType: resourceType,
+				AccountType:  accountType.String(),
+				Status:       getUserStatus(ctx, a.User),
+				CreatedAt:    a.User.GetCreatedAt(),
+				LastLogin:    a.User.GetLastLogin(),
+				Secrets:      secrets[expansion.ResourceKey(a.Resource.Id)],
+			}
+
+			sa.Entitlements, sa.InheritedEntitlements, err = s.effectiveEntitlements(ctx, a.Resource.Id)
+			if err != nil {
+				return err
+			}
+
+			for _, en := range append(sa.Entitlements, sa.InheritedEntitlements...) {
+				ok, err := classifier.IsPrivileged(ctx, s.sc, en)
+				if err != nil {
+					return err
+				}
+				if ok {
+					sa.PrivilegedEntitlements = append(sa.PrivilegedEntitlements, en)
+				}
+			}
+
+			report.ServiceAccounts = append(report.ServiceAccounts, sa)
+		}
+	}
+
+	sort.SliceStable(report.ServiceAccounts, func(i, j int) bool {
+		a, b := report.ServiceAccounts[i], report.ServiceAccounts[j]
+		if len(a.PrivilegedEntitlements) != len(b.PrivilegedEntitlements) {
+			return len(a.PrivilegedEntitlements) > len(b.PrivilegedEntitlements)
+		}
+		return len(a.Entitlements)+len(a.InheritedEntitlements) > len(b.Entitlements)+len(b.InheritedEntitlements)
+	})
+
+	err = outputManager.Output(ctx, report)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -20,6 +20,7 @@ func resourceTypesCmd() *cobra.Command {
 	}
 
 	addSyncIDFlag(cmd)
+	addPaginationFlags(cmd)
 
 	return cmd
 }
@@ -45,6 +46,11 @@ func runResourceTypes(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	pager, err := newPaginator(cmd)
+	if err != nil {
+		return err
+	}
+
 	m, err := manager.New(ctx, c1zPath)
 	if err != nil {
 		return err
@@ -64,26 +70,39 @@ func runResourceTypes(cmd *cobra.Command, args []string) error {
 	}
 
 	var resourceTypes []*v1.ResourceTypeOutput
-	pageToken := ""
+	pageToken := pager.PageToken()
 	for {
-		resp, err := store.ListResourceTypes(ctx, &v2.ResourceTypesServiceListResourceTypesRequest{PageToken: pageToken})
+		resp, err := store.ListResourceTypes(ctx, &v2.ResourceTypesServiceListResourceTypesRequest{
+			PageSize:  pager.PageSize(),
+			PageToken: pageToken,
+		})
 		if err != nil {
 			return err
 		}
 
 		for _, rt := range resp.List {
+			if !pager.Take() || pager.CountOnly() {
+				continue
+			}
 			resourceTypes = append(resourceTypes, &v1.ResourceTypeOutput{ResourceType: rt})
 		}
 
-		if resp.NextPageToken == "" {
+		pageToken = resp.NextPageToken
+		if pageToken == "" || pager.Done() {
 			break
 		}
+	}
 
-		pageToken = resp.NextPageToken
+	if pager.CountOnly() {
+		return outputManager.Output(ctx, &v1.CountOutput{
+			Count:         pager.Count(),
+			NextPageToken: pageToken,
+		})
 	}
 
 	err = outputManager.Output(ctx, &v1.ResourceTypeListOutput{
 		ResourceTypes: resourceTypes,
+		NextPageToken: pageToken,
 	})
 	if err != nil {
 		return err
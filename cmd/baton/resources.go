@@ -22,6 +22,7 @@ func resourcesCmd() *cobra.Command {
 
 	addResourceTypeFlag(cmd)
 	addSyncIDFlag(cmd)
+	addPaginationFlags(cmd)
 
 	return cmd
 }
@@ -52,6 +53,11 @@ func runResources(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	pager, err := newPaginator(cmd)
+	if err != nil {
+		return err
+	}
+
 	m, err := manager.New(ctx, c1zPath)
 	if err != nil {
 		return err
@@ -73,10 +79,11 @@ func runResources(cmd *cobra.Command, args []string) error {
 	sc := storecache.NewStoreCache(ctx, store)
 
 	var resources []*v1.ResourceOutput
-	pageToken := ""
+	pageToken := pager.PageToken()
 	for {
 		resp, err := store.ListResources(ctx, &v2.ResourcesServiceListResourcesRequest{
 			ResourceTypeId: resourceType,
+			PageSize:       pager.PageSize(),
 			PageToken:      pageToken,
 		})
 		if err != nil {
@@ -84,6 +91,10 @@ func runResources(cmd *cobra.Command, args []string) error {
 		}
 
 		for _, r := range resp.List {
+			if !pager.Take() || pager.CountOnly() {
+				continue
+			}
+
 			rt, err := sc.GetResourceType(ctx, r.Id.ResourceType)
 			if err != nil {
 				return err
@@ -104,15 +115,22 @@ func runResources(cmd *cobra.Command, args []string) error {
 			})
 		}
 
-		if resp.NextPageToken == "" {
+		pageToken = resp.NextPageToken
+		if pageToken == "" || pager.Done() {
 			break
 		}
+	}
 
-		pageToken = resp.NextPageToken
+	if pager.CountOnly() {
+		return outputManager.Output(ctx, &v1.CountOutput{
+			Count:         pager.Count(),
+			NextPageToken: pageToken,
+		})
 	}
 
 	err = outputManager.Output(ctx, &v1.ResourceListOutput{
-		Resources: resources,
+		Resources:     resources,
+		NextPageToken: pageToken,
 	})
 	if err != nil {
 		return err
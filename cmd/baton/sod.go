@@ -0,0 +1,340 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/conductorone/baton-sdk/pkg/logging"
+	v1 "github.com/conductorone/baton/pb/baton/v1"
+	"github.com/conductorone/baton/pkg/identity"
+	"github.com/conductorone/baton/pkg/output"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
+)
+
+// sodRules is the layout of a separation of duties rules file.
+//
+//	rules:
+//	  - name: billing-and-approval
+//	    description: Billing admins must not approve finance requests
+//	    severity: high
+//	    conflicts:
+//	      - name: AWS billing admin
+//	        file: aws*.c1z
+//	        slugs: ["*billing*admin*"]
+//	      - name: Finance approver
+//	        entitlement_ids: ["group:finance-approvers:member"]
+type sodRules struct {
+	Rules []*sodRule `yaml:"rules"`
+}
+
+type sodRule struct {
+	Name        string        `yaml:"name"`
+	Description string        `yaml:"description"`
+	Severity    string        `yaml:"severity"`
+	Conflicts   []*sodMatcher `yaml:"conflicts"`
+}
+
+// sodMatcher selects entitlements by ID, or by slug pattern and resource type. File optionally limits the matcher to
+// c1z files whose base name matches the pattern.
+type sodMatcher struct {
+	Name           string   `yaml:"name"`
+	File           string   `yaml:"file"`
+	EntitlementIDs []string `yaml:"entitlement_ids"`
+	Slugs          []string `yaml:"slugs"`
+	ResourceTypes  []string `yaml:"resource_types"`
+}
+
+func loadSodRules(rulesPath string) (*sodRules, error) {
+	data, err := os.ReadFile(rulesPath)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := &sodRules{}
+	err = yaml.Unmarshal(data, rules)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", rulesPath, err)
+	}
+
+	if len(rules.Rules) == 0 {
+		return nil, fmt.Errorf("%s does not contain any rules", rulesPath)
+	}
+
+	for i, r := range rules.Rules {
+		if r.Name == "" {
+			return nil, fmt.Errorf("rule %d is missing a name", i+1)
+		}
+		if len(r.Conflicts) < 2 {
+			return nil, fmt.Errorf("rule %s must have at least two conflicts", r.Name)
+		}
+		for j, c := range r.Conflicts {
+			if len(c.EntitlementIDs) == 0 && len(c.Slugs) == 0 && len(c.ResourceTypes) == 0 {
+				return nil, fmt.Errorf("conflict %d of rule %s must set entitlement_ids, slugs or resource_types", j+1, r.Name)
+			}
+			for _, pattern := range append([]string{c.File}, c.Slugs...) {
+				if _, err := path.Match(pattern, ""); err != nil {
+					return nil, fmt.Errorf("rule %s has an invalid pattern %q: %w", r.Name, pattern, err)
+				}
+			}
+			if c.Name == "" {
+				c.Name = fmt.Sprintf("conflict %d", j+1)
+			}
+		}
+	}
+
+	return rules, nil
+}
+
+func (m *sodMatcher) Matches(file string, en *v2.Entitlement) bool {
+	if m.File != "" {
+		if ok, _ := path.Match(m.File, filepath.Base(file)); !ok {
+			return false
+		}
+	}
+
+	if slices.Contains(m.EntitlementIDs, en.Id) {
+		return true
+	}
+
+	if len(m.Slugs) == 0 && len(m.ResourceTypes) == 0 {
+		return false
+	}
+
+	if len(m.ResourceTypes) > 0 && !slices.Contains(m.ResourceTypes, en.GetResource().GetId().GetResourceType()) {
+		return false
+	}
+
+	if len(m.Slugs) == 0 {
+		return true
+	}
+
+	slug := strings.ToLower(en.Slug)
+	for _, pattern := range m.Slugs {
+		if ok, _ := path.Match(strings.ToLower(pattern), slug); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sodCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sod",
+		Short: "Separation of duties checks",
+	}
+
+	cmd.AddCommand(sodCheckCmd())
+
+	return cmd
+}
+
+func sodCheckCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check [c1z files...]",
+		Short: "Check principals' effective access against separation of duties rules. Users are correlated across files by email.",
+		RunE:  runSodCheck,
+	}
+
+	cmd.Flags().String("rules", "sod.yaml", "The path to the separation of duties rules file")
+	addSyncIDFlag(cmd)
+
+	return cmd
+}
+
+func runSodCheck(cmd *cobra.Command, args []string) error {
+	ctx, err := logging.Init(context.Background(), logging.WithLogFormat("console"), logging.WithLogLevel("error"))
+	if err != nil {
+		return err
+	}
+
+	c1zPaths, err := getC1ZPaths(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	outputFormat, err := cmd.Flags().GetString("output-format")
+	if err != nil {
+		return err
+	}
+	outputManager := output.NewManager(ctx, outputFormat)
+
+	syncID, err := cmd.Flags().GetString("sync-id")
+	if err != nil {
+		return err
+	}
+
+	rulesPath, err := cmd.Flags().GetString("rules")
+	if err != nil {
+		return err
+	}
+	if rulesPath == "" {
+		return errors.New("--rules is required")
+	}
+
+	rules, err := loadSodRules(rulesPath)
+	if err != nil {
+		return err
+	}
+
+	sources, err := openC1ZSources(ctx, c1zPaths, syncID)
+	defer closeC1ZSources(ctx, sources)
+	if err != nil {
+		return err
+	}
+
+	sourcesByPath := make(map[string]*c1zSource)
+	var accounts []*identity.Account
+	for _, s := range sources {
+		sourcesByPath[s.path] = s
+
+		sourceAccounts, err := s.principalAccounts(ctx)
+		if err != nil {
+			return err
+		}
+		accounts = append(accounts, sourceAccounts...)
+	}
+
+	identities := identity.Correlate(accounts)
+
+	var violations []*v1.SodViolationOutput
+	for _, ident := range identities {
+		identityViolations, err := checkSodIdentity(ctx, sourcesByPath, rules, ident)
+		if err != nil {
+			return err
+		}
+		violations = append(violations, identityViolations...)
+	}
+
+	err = outputManager.Output(ctx, &v1.SodCheckOutput{
+		Files:               c1zPaths,
+		RulesEvaluated:      uint32(len(rules.Rules)),
+		IdentitiesEvaluated: uint32(len(identities)),
+		Violations:          violations,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func checkSodIdentity(
+	ctx context.Context,
+	sourcesByPath map[string]*c1zSource,
+	rules *sodRules,
+	ident *identity.Identity,
+) ([]*v1.SodViolationOutput, error) {
+	// matches[rule][conflict] holds the grants that satisfied each side of a rule.
+	matches := make([][][]*v1.SodGrantOutput, len(rules.Rules))
+	for i, r := range rules.Rules {
+		matches[i] = make([][]*v1.SodGrantOutput, len(r.Conflicts))
+	}
+
+	for _, a := range ident.Accounts {
+		s := sourcesByPath[a.Source]
+		graph, err := s.Graph(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, access := range graph.EffectiveAccess(a.Resource.Id) {
+			en, err := s.sc.GetEntitlement(ctx, access.EntitlementID)
+			if err != nil {
+				return nil, err
+			}
+
+			for i, r := range rules.Rules {
+				for j, c := range r.Conflicts {
+					if !c.Matches(s.path, en) {
+						continue
+					}
+
+					var resource *v2.Resource
+					if en.GetResource().GetId() != nil {
+						resource, err = s.sc.GetResource(ctx, en.Resource.Id)
+						if err != nil {
+							return nil, err
+						}
+					}
+
+					matches[i][j] = append(matches[i][j], &v1.SodGrantOutput{
+						File:        s.path,
+						Conflict:    c.Name,
+						Principal:   a.Resource,
+						Entitlement: en,
+						Resource:    resource,
+						Direct:      access.Direct,
+					})
+				}
+			}
+		}
+	}
+
+	var ret []*v1.SodViolationOutput
+	for i, r := range rules.Rules {
+		if !sodSidesSatisfied(matches[i]) {
+			continue
+		}
+
+		v := &v1.SodViolationOutput{
+			Rule:        r.Name,
+			Description: r.Description,
+			Severity:    r.Severity,
+			Identity:    ident.Key,
+			DisplayName: ident.DisplayName(),
+		}
+		for _, m := range matches[i] {
+			v.Grants = append(v.Grants, m...)
+		}
+		ret = append(ret, v)
+	}
+
+	return ret, nil
+}
+
+// sodSidesSatisfied reports whether every side of a rule can be satisfied by a different entitlement, so that a single
+// entitlement matching several sides of a rule is not reported as a conflict with itself. Sides are assigned with
+// augmenting paths, as a bipartite matching between sides and the entitlements that satisfy them.
+func sodSidesSatisfied(sides [][]*v1.SodGrantOutput) bool {
+	entitlementKey := func(g *v1.SodGrantOutput) string {
+		return g.File + "\x00" + g.Entitlement.GetId()
+	}
+
+	assigned := make(map[string]int)
+	var assign func(side int, visited map[string]bool) bool
+	assign = func(side int, visited map[string]bool) bool {
+		for _, g := range sides[side] {
+			key := entitlementKey(g)
+			if visited[key] {
+				continue
+			}
+			visited[key] = true
+
+			other, ok := assigned[key]
+			if !ok || assign(other, visited) {
+				assigned[key] = side
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for side := range sides {
+		if !assign(side, make(map[string]bool)) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,357 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/conductorone/baton-sdk/pkg/connectorstore"
+	"github.com/conductorone/baton-sdk/pkg/dotc1z"
+	"github.com/conductorone/baton-sdk/pkg/dotc1z/manager"
+	"github.com/conductorone/baton/pkg/expansion"
+	"github.com/conductorone/baton/pkg/identity"
+	"github.com/conductorone/baton/pkg/storecache"
+	"github.com/spf13/cobra"
+
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
+)
+
+// syncRunInfo is a finished sync recorded in a c1z file.
+type syncRunInfo struct {
+	id        string
+	startedAt time.Time
+}
+
+// c1zSource is an opened c1z file along with the caches that commands working across several files share.
+type c1zSource struct {
+	path  string
+	m     manager.Manager
+	store *dotc1z.C1File
+	sc    *storecache.StoreCache
+	graph *expansion.Graph
+}
+
+// getC1ZPaths returns the c1z files passed as arguments, or listed in --manifest for commands that have it, falling
+// back to --file when there are none. Arguments and manifest entries may be glob patterns.
+func getC1ZPaths(cmd *cobra.Command, args []string) ([]string, error) {
+	paths, err := expandC1ZPaths(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.Flags().Lookup(manifestFlag) != nil {
+		manifestPath, err := cmd.Flags().GetString(manifestFlag)
+		if err != nil {
+			return nil, err
+		}
+		if manifestPath != "" {
+			manifestPaths, err := loadC1ZManifest(manifestPath)
+			if err != nil {
+				return nil, err
+			}
+			paths = append(paths, manifestPaths...)
+		}
+	}
+
+	if len(paths) > 0 {
+		return paths, nil
+	}
+
+	c1zPath, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{c1zPath}, nil
+}
+
+// expandC1ZPaths expands the glob patterns among the paths. Paths without glob characters are returned as is.
+func expandC1ZPaths(patterns []string) ([]string, error) {
+	var ret []string
+	seen := make(map[string]struct{})
+	for _, pattern := range patterns {
+		matches := []string{pattern}
+		if strings.ContainsAny(pattern, "*?[") {
+			var err error
+			matches, err = filepath.Glob(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+			}
+			if len(matches) == 0 {
+				return nil, fmt.Errorf("no c1z files match %s", pattern)
+			}
+		}
+
+		for _, m := range matches {
+			if _, ok := seen[m]; ok {
+				continue
+			}
+			seen[m] = struct{}{}
+			ret = append(ret, m)
+		}
+	}
+
+	return ret, nil
+}
+
+// loadC1ZManifest reads a manifest listing c1z files or glob patterns, one per line. Blank lines and lines starting with
+// # are ignored, and relative paths are resolved against the manifest's directory.
+func loadC1ZManifest(manifestPath string) ([]string, error) {
+	f, err := os.Open(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var patterns []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if !filepath.IsAbs(line) {
+			line = filepath.Join(filepath.Dir(manifestPath), line)
+		}
+		patterns = append(patterns, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(patterns) == 0 {
+		return nil, fmt.Errorf("%s does not list any c1z files", manifestPath)
+	}
+
+	return expandC1ZPaths(patterns)
+}
+
+func openC1ZSource(ctx context.Context, path string, syncID string) (*c1zSource, error) {
+	m, err := manager.New(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := m.LoadC1Z(ctx)
+	if err != nil {
+		_ = m.Close(ctx)
+		return nil, err
+	}
+
+	if syncID != "" {
+		err = store.ViewSync(ctx, syncID)
+		if err != nil {
+			_ = m.Close(ctx)
+			return nil, err
+		}
+	}
+
+	return &c1zSource{
+		path:  path,
+		m:     m,
+		store: store,
+		sc:    storecache.NewStoreCache(ctx, store),
+	}, nil
+}
+
+// openC1ZSources opens every path. The returned sources must be closed with closeC1ZSources, even on error. Sync IDs
+// are specific to a file, so a sync ID can only be given when there is a single path.
+func openC1ZSources(ctx context.Context, paths []string, syncID string) ([]*c1zSource, error) {
+	if syncID != "" && len(paths) > 1 {
+		return nil, fmt.Errorf("--sync-id can only be used with a single c1z file")
+	}
+
+	var ret []*c1zSource
+	for _, path := range paths {
+		s, err := openC1ZSource(ctx, path, syncID)
+		if err != nil {
+			return ret, err
+		}
+		ret = append(ret, s)
+	}
+
+	return ret, nil
+}
+
+func closeC1ZSources(ctx context.Context, sources []*c1zSource) {
+	for _, s := range sources {
+		_ = s.m.Close(ctx)
+	}
+}
+
+// Graph loads the expansion graph for the source the first time it is needed.
+func (s *c1zSource) Graph(ctx context.Context) (*expansion.Graph, error) {
+	if s.graph != nil {
+		return s.graph, nil
+	}
+
+	graph, err := expansion.Load(ctx, s.store)
+	if err != nil {
+		return nil, err
+	}
+	s.graph = graph
+
+	return graph, nil
+}
+
+// effectiveEntitlements returns the entitlements the principal holds, split into those granted directly and those
+// inherited through expansion.
+func (s *c1zSource) effectiveEntitlements(ctx context.Context, principal *v2.ResourceId) ([]*v2.Entitlement, []*v2.Entitlement, error) {
+	graph, err := s.Graph(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var direct, inherited []*v2.Entitlement
+	for _, access := range graph.EffectiveAccess(principal) {
+		en, err := s.sc.GetEntitlement(ctx, access.EntitlementID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if access.Direct {
+			direct = append(direct, en)
+		} else {
+			inherited = append(inherited, en)
+		}
+	}
+
+	return direct, inherited, nil
+}
+
+// principalAccounts returns an account for every principal in the source that holds at least one grant.
+func (s *c1zSource) principalAccounts(ctx context.Context) ([]*identity.Account, error) {
+	graph, err := s.Graph(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []*identity.Account
+	for _, principalID := range graph.Principals() {
+		r, err := s.sc.GetResource(ctx, principalID)
+		if err != nil {
+			return nil, err
+		}
+
+		a, err := identity.NewAccount(s.path, r)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, a)
+	}
+
+	return ret, nil
+}
+
+// resourcesWithTrait calls fn for every resource of a resource type with the given trait.
+func (s *c1zSource) resourcesWithTrait(ctx context.Context, trait v2.ResourceType_Trait, fn func(r *v2.Resource) error) error {
+	return s.listResources(ctx, func(rt *v2.ResourceType) bool {
+		return slices.Contains(rt.Traits, trait)
+	}, fn)
+}
+
+// listResources calls fn for every resource of the resource types that include returns true for.
+func (s *c1zSource) listResources(ctx context.Context, include func(rt *v2.ResourceType) bool, fn func(r *v2.Resource) error) error {
+	var resourceTypes []string
+	pageToken := ""
+	for {
+		resp, err := s.store.ListResourceTypes(ctx, &v2.ResourceTypesServiceListResourceTypesRequest{PageToken: pageToken})
+		if err != nil {
+			return err
+		}
+
+		for _, rt := range resp.List {
+			if include(rt) {
+				resourceTypes = append(resourceTypes, rt.Id)
+			}
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	for _, rtID := range resourceTypes {
+		pageToken = ""
+		for {
+			resp, err := s.store.ListResources(ctx, &v2.ResourcesServiceListResourcesRequest{
+				ResourceTypeId: rtID,
+				PageToken:      pageToken,
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, r := range resp.List {
+				err = fn(r)
+				if err != nil {
+					return err
+				}
+			}
+
+			if resp.NextPageToken == "" {
+				break
+			}
+			pageToken = resp.NextPageToken
+		}
+	}
+
+	return nil
+}
+
+// userAccounts returns an account for every resource of a resource type with the user trait.
+func (s *c1zSource) userAccounts(ctx context.Context) ([]*identity.Account, error) {
+	var ret []*identity.Account
+	err := s.resourcesWithTrait(ctx, v2.ResourceType_TRAIT_USER, func(r *v2.Resource) error {
+		a, err := identity.NewAccount(s.path, r)
+		if err != nil {
+			return err
+		}
+		ret = append(ret, a)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// fullSyncs returns the finished full syncs in the source, oldest first.
+func (s *c1zSource) fullSyncs(ctx context.Context) ([]*syncRunInfo, error) {
+	var ret []*syncRunInfo
+	pageToken := ""
+	for {
+		resp, nextPageToken, err := s.store.ListSyncRuns(ctx, pageToken, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, sr := range resp {
+			if sr.EndedAt == nil || sr.StartedAt == nil || sr.Type != connectorstore.SyncTypeFull {
+				continue
+			}
+			ret = append(ret, &syncRunInfo{
+				id:        sr.ID,
+				startedAt: *sr.StartedAt,
+			})
+		}
+
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+
+	slices.SortStableFunc(ret, func(a, b *syncRunInfo) int {
+		return a.startedAt.Compare(b.startedAt)
+	})
+
+	return ret, nil
+}
@@ -18,6 +18,8 @@ func syncsCmd() *cobra.Command {
 		RunE:  runSyncList,
 	}
 
+	addPaginationFlags(cmd)
+
 	return cmd
 }
 
@@ -37,6 +39,11 @@ func runSyncList(cmd *cobra.Command, args []string) error {
 	}
 	outputManager := output.NewManager(ctx, outputFormat)
 
+	pager, err := newPaginator(cmd)
+	if err != nil {
+		return err
+	}
+
 	m, err := manager.New(ctx, c1zPath)
 	if err != nil {
 		return err
@@ -49,14 +56,23 @@ func runSyncList(cmd *cobra.Command, args []string) error {
 	}
 
 	var syncRuns []*v1.SyncOutput
-	pageToken := ""
+	pageToken := pager.PageToken()
 	for {
-		resp, nextPageToken, err := store.ListSyncRuns(ctx, pageToken, 100)
+		pageSize := pager.PageSize()
+		if pageSize == 0 {
+			pageSize = 100
+		}
+
+		resp, nextPageToken, err := store.ListSyncRuns(ctx, pageToken, pageSize)
 		if err != nil {
 			return err
 		}
 
 		for _, sr := range resp {
+			if !pager.Take() || pager.CountOnly() {
+				continue
+			}
+
 			var startTime *timestamppb.Timestamp
 			if sr.StartedAt != nil {
 				startTime = timestamppb.New(*sr.StartedAt)
@@ -76,15 +92,22 @@ func runSyncList(cmd *cobra.Command, args []string) error {
 			})
 		}
 
-		if nextPageToken == "" {
+		pageToken = nextPageToken
+		if pageToken == "" || pager.Done() {
 			break
 		}
+	}
 
-		pageToken = nextPageToken
+	if pager.CountOnly() {
+		return outputManager.Output(ctx, &v1.CountOutput{
+			Count:         pager.Count(),
+			NextPageToken: pageToken,
+		})
 	}
 
 	err = outputManager.Output(ctx, &v1.SyncListOutput{
-		Syncs: syncRuns,
+		Syncs:         syncRuns,
+		NextPageToken: pageToken,
 	})
 	if err != nil {
 		return err
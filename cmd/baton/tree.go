@@ -0,0 +1,272 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/conductorone/baton-sdk/pkg/logging"
+	v1 "github.com/conductorone/baton/pb/baton/v1"
+	"github.com/conductorone/baton/pkg/expansion"
+	"github.com/conductorone/baton/pkg/output"
+	"github.com/spf13/cobra"
+
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
+)
+
+func treeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tree",
+		Short: "Show the resource hierarchy",
+		RunE:  runTree,
+	}
+
+	addSyncIDFlag(cmd)
+	cmd.Flags().StringP(resourceTypeFlag, "t", "", "Only show the subtrees rooted at resources of this resource type")
+	cmd.Flags().StringP(resourceFlag, "r", "", "Only show the subtree rooted at this resource. Requires --resource-type.")
+	cmd.Flags().Uint32("depth", 0, "The maximum depth of the tree. Shows every level if not set.")
+	cmd.Flags().Bool("counts", false, "Include the number of entitlements and grants on each resource")
+
+	return cmd
+}
+
+// childResourceTypes returns the resource type IDs of the ChildResourceType annotations on the resource.
+func childResourceTypes(r *v2.Resource) ([]string, error) {
+	var ret []string
+	for _, a := range r.Annotations {
+		if !a.MessageIs(&v2.ChildResourceType{}) {
+			continue
+		}
+
+		crt := &v2.ChildResourceType{}
+		err := a.UnmarshalTo(crt)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, crt.ResourceTypeId)
+	}
+
+	return ret, nil
+}
+
+type resourceTree struct {
+	s     *c1zSource
+	graph *expansion.Graph
+	// entitlements counts the entitlements on each resource, including the ones nothing grants.
+	entitlements map[string]uint32
+	children     map[string][]*v2.Resource
+	maxDepth     uint32
+	ancestors    map[string]struct{}
+}
+
+func (t *resourceTree) node(ctx context.Context, r *v2.Resource, depth uint32) (*v1.ResourceTreeNode, error) {
+	resourceType, err := t.s.sc.GetResourceType(ctx, r.Id.ResourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	childTypes, err := childResourceTypes(r)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &v1.ResourceTreeNode{
+		Resource:           r,
+		ResourceType:       resourceType,
+		ChildResourceTypes: childTypes,
+	}
+
+	key := expansion.ResourceKey(r.Id)
+	if t.graph != nil {
+		ret.Entitlements = t.entitlements[key]
+		for _, enID := range t.graph.EntitlementsForResource(r.Id) {
+			ret.Grants += uint32(len(t.graph.DirectGrantsForEntitlement(enID)))
+		}
+	}
+
+	children := t.children[key]
+	if len(children) == 0 {
+		return ret, nil
+	}
+	if t.maxDepth != 0 && depth >= t.maxDepth {
+		ret.Truncated = true
+		return ret, nil
+	}
+
+	// Connectors should never produce cycles, but a bad parent ID must not hang the command.
+	if _, ok := t.ancestors[key]; ok {
+		ret.Truncated = true
+		return ret, nil
+	}
+	t.ancestors[key] = struct{}{}
+	defer delete(t.ancestors, key)
+
+	for _, child := range children {
+		n, err := t.node(ctx, child, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		ret.Children = append(ret.Children, n)
+	}
+
+	return ret, nil
+}
+
+// hasParentOfType reports whether the resource's parent was synced and is of the given resource type.
+func hasParentOfType(r *v2.Resource, resourceTypeID string, present map[string]struct{}) bool {
+	if r.ParentResourceId == nil || r.ParentResourceId.ResourceType != resourceTypeID {
+		return false
+	}
+
+	_, ok := present[expansion.ResourceKey(r.ParentResourceId)]
+	return ok
+}
+
+func runTree(cmd *cobra.Command, args []string) error {
+	ctx, err := logging.Init(context.Background(), logging.WithLogFormat("console"), logging.WithLogLevel("error"))
+	if err != nil {
+		return err
+	}
+
+	c1zPath, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	outputFormat, err := cmd.Flags().GetString("output-format")
+	if err != nil {
+		return err
+	}
+	outputManager := output.NewManager(ctx, outputFormat)
+
+	syncID, err := cmd.Flags().GetString("sync-id")
+	if err != nil {
+		return err
+	}
+
+	resourceTypeID, err := cmd.Flags().GetString(resourceTypeFlag)
+	if err != nil {
+		return err
+	}
+
+	resourceID, err := cmd.Flags().GetString(resourceFlag)
+	if err != nil {
+		return err
+	}
+	if resourceID != "" && resourceTypeID == "" {
+		return fmt.Errorf("--%s is required when --%s is set", resourceTypeFlag, resourceFlag)
+	}
+
+	maxDepth, err := cmd.Flags().GetUint32("depth")
+	if err != nil {
+		return err
+	}
+
+	counts, err := cmd.Flags().GetBool("counts")
+	if err != nil {
+		return err
+	}
+
+	s, err := openC1ZSource(ctx, c1zPath, syncID)
+	if err != nil {
+		return err
+	}
+	defer closeC1ZSources(ctx, []*c1zSource{s})
+
+	t := &resourceTree{
+		s:            s,
+		entitlements: make(map[string]uint32),
+		children:     make(map[string][]*v2.Resource),
+		maxDepth:     maxDepth,
+		ancestors:    make(map[string]struct{}),
+	}
+	if counts {
+		t.graph, err = s.Graph(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = listAllEntitlements(ctx, s, func(en *v2.Entitlement) error {
+			if rID := en.GetResource().GetId(); rID != nil {
+				t.entitlements[expansion.ResourceKey(rID)]++
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	var all []*v2.Resource
+	err = s.listResources(ctx, func(rt *v2.ResourceType) bool { return true }, func(r *v2.Resource) error {
+		all = append(all, r)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	sort.SliceStable(all, func(i, j int) bool {
+		if all[i].Id.ResourceType != all[j].Id.ResourceType {
+			return all[i].Id.ResourceType < all[j].Id.ResourceType
+		}
+		return all[i].DisplayName < all[j].DisplayName
+	})
+
+	present := make(map[string]struct{}, len(all))
+	for _, r := range all {
+		present[expansion.ResourceKey(r.Id)] = struct{}{}
+	}
+
+	var roots []*v2.Resource
+	for _, r := range all {
+		switch {
+		case resourceID != "":
+			if r.Id.ResourceType == resourceTypeID && r.Id.Resource == resourceID {
+				roots = append(roots, r)
+			}
+		case resourceTypeID != "":
+			// A resource nested under another resource of the same type is already shown in its parent's subtree.
+			if r.Id.ResourceType == resourceTypeID && !hasParentOfType(r, resourceTypeID, present) {
+				roots = append(roots, r)
+			}
+		}
+
+		if r.ParentResourceId == nil {
+			if resourceTypeID == "" {
+				roots = append(roots, r)
+			}
+			continue
+		}
+
+		parentKey := expansion.ResourceKey(r.ParentResourceId)
+		if _, ok := present[parentKey]; !ok {
+			// Resources whose parent was not synced are shown at the top level rather than dropped.
+			if resourceTypeID == "" {
+				roots = append(roots, r)
+			}
+			continue
+		}
+		t.children[parentKey] = append(t.children[parentKey], r)
+	}
+
+	if resourceID != "" && len(roots) == 0 {
+		return fmt.Errorf("resource %s:%s not found", resourceTypeID, resourceID)
+	}
+
+	report := &v1.ResourceTreeOutput{}
+	for _, r := range roots {
+		n, err := t.node(ctx, r, 1)
+		if err != nil {
+			return err
+		}
+		report.Roots = append(report.Roots, n)
+	}
+
+	err = outputManager.Output(ctx, report)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
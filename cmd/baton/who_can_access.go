@@ -0,0 +1,242 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/conductorone/baton-sdk/pkg/dotc1z/manager"
+	"github.com/conductorone/baton-sdk/pkg/logging"
+	v1 "github.com/conductorone/baton/pb/baton/v1"
+	"github.com/conductorone/baton/pkg/expansion"
+	"github.com/conductorone/baton/pkg/output"
+	"github.com/conductorone/baton/pkg/storecache"
+	"github.com/spf13/cobra"
+
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
+)
+
+func whoCanAccessCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "who-can-access",
+		Short: "List every principal with access to a resource, including access inherited through groups and roles",
+		RunE:  runWhoCanAccess,
+	}
+
+	addResourceTypeFlag(cmd)
+	addResourceFlag(cmd)
+	addSyncIDFlag(cmd)
+	cmd.Flags().Bool("show-paths", false, "Show how each principal came to hold each entitlement")
+	cmd.Flags().Int("max-paths", 10, "The maximum number of paths to show per principal when --show-paths is set. Set to 0 to show every path.")
+
+	cmd.MarkFlagsRequiredTogether(resourceTypeFlag, resourceFlag)
+
+	return cmd
+}
+
+// whoCanAccess collects the holders of a single entitlement, including the members of any groups it is passed on to.
+type whoCanAccess struct {
+	sc        *storecache.StoreCache
+	graph     *expansion.Graph
+	showPaths bool
+	maxPaths  int
+}
+
+func (w *whoCanAccess) paths(ctx context.Context, principal *v2.ResourceId, entitlementID string) ([]*v1.AccessPath, error) {
+	if !w.showPaths {
+		return nil, nil
+	}
+
+	var ret []*v1.AccessPath
+	for _, p := range w.graph.Paths(principal, entitlementID, w.maxPaths) {
+		path := &v1.AccessPath{}
+		for _, hop := range p {
+			hopOutput, err := accessPathHopOutput(ctx, w.sc, hop)
+			if err != nil {
+				return nil, err
+			}
+			path.Hops = append(path.Hops, hopOutput)
+		}
+		ret = append(ret, path)
+	}
+
+	return ret, nil
+}
+
+// holders returns every principal that holds the entitlement. Access is only passed on through the entitlements
+// named by a grant's GrantExpandable annotation, so holding a group's owner or admin entitlement does not make a
+// principal a holder of what the group's members receive.
+func (w *whoCanAccess) holders(ctx context.Context, entitlement *v2.Entitlement) ([]*v1.AccessHolderOutput, error) {
+	var ret []*v1.AccessHolderOutput
+	for _, h := range w.graph.EffectiveHolders(entitlement.Id) {
+		principal, err := w.sc.GetResource(ctx, h.Principal)
+		if err != nil {
+			return nil, err
+		}
+
+		principalType, err := w.sc.GetResourceType(ctx, h.Principal.ResourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		holder := &v1.AccessHolderOutput{
+			Principal:     principal,
+			PrincipalType: principalType,
+			Direct:        h.Access.Direct,
+		}
+
+		if !h.Access.Direct {
+			seen := make(map[string]struct{})
+			for _, e := range h.Access.Via {
+				key := expansion.ResourceKey(e.Principal)
+				if _, ok := seen[key]; ok {
+					continue
+				}
+				seen[key] = struct{}{}
+
+				group, err := w.sc.GetResource(ctx, e.Principal)
+				if err != nil {
+					return nil, err
+				}
+				holder.ViaGroups = append(holder.ViaGroups, group)
+			}
+		}
+
+		holder.Paths, err = w.paths(ctx, h.Principal, entitlement.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		ret = append(ret, holder)
+	}
+
+	return ret, nil
+}
+
+func runWhoCanAccess(cmd *cobra.Command, args []string) error {
+	ctx, err := logging.Init(context.Background(), logging.WithLogFormat("console"), logging.WithLogLevel("error"))
+	if err != nil {
+		return err
+	}
+	c1zPath, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	outputFormat, err := cmd.Flags().GetString("output-format")
+	if err != nil {
+		return err
+	}
+	outputManager := output.NewManager(ctx, outputFormat)
+
+	syncID, err := cmd.Flags().GetString("sync-id")
+	if err != nil {
+		return err
+	}
+
+	resourceTypeID, err := cmd.Flags().GetString(resourceTypeFlag)
+	if err != nil {
+		return err
+	}
+	resourceID, err := cmd.Flags().GetString(resourceFlag)
+	if err != nil {
+		return err
+	}
+	if resourceTypeID == "" || resourceID == "" {
+		return fmt.Errorf("--%s and --%s are required", resourceTypeFlag, resourceFlag)
+	}
+
+	showPaths, err := cmd.Flags().GetBool("show-paths")
+	if err != nil {
+		return err
+	}
+
+	maxPaths, err := cmd.Flags().GetInt("max-paths")
+	if err != nil {
+		return err
+	}
+
+	m, err := manager.New(ctx, c1zPath)
+	if err != nil {
+		return err
+	}
+	defer m.Close(ctx)
+
+	store, err := m.LoadC1Z(ctx)
+	if err != nil {
+		return err
+	}
+
+	if syncID != "" {
+		err = store.ViewSync(ctx, syncID)
+		if err != nil {
+			return err
+		}
+	}
+
+	sc := storecache.NewStoreCache(ctx, store)
+
+	graph, err := expansion.Load(ctx, store)
+	if err != nil {
+		return err
+	}
+
+	resource, err := sc.GetResource(ctx, &v2.ResourceId{
+		ResourceType: resourceTypeID,
+		Resource:     resourceID,
+	})
+	if err != nil {
+		return err
+	}
+
+	resourceType, err := sc.GetResourceType(ctx, resourceTypeID)
+	if err != nil {
+		return err
+	}
+
+	w := &whoCanAccess{
+		sc:        sc,
+		graph:     graph,
+		showPaths: showPaths,
+		maxPaths:  maxPaths,
+	}
+
+	var entitlements []*v1.EntitlementHoldersOutput
+	pageToken := ""
+	for {
+		resp, err := store.ListEntitlements(ctx, &v2.EntitlementsServiceListEntitlementsRequest{
+			Resource:  resource,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, en := range resp.List {
+			holders, err := w.holders(ctx, en)
+			if err != nil {
+				return err
+			}
+
+			entitlements = append(entitlements, &v1.EntitlementHoldersOutput{
+				Entitlement: en,
+				Holders:     holders,
+			})
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	err = outputManager.Output(ctx, &v1.WhoCanAccessOutput{
+		Resource:     resource,
+		ResourceType: resourceType,
+		Entitlements: entitlements,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
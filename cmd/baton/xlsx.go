@@ -3,17 +3,30 @@ package main
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/conductorone/baton-sdk/pkg/annotations"
-	"github.com/conductorone/baton-sdk/pkg/dotc1z/manager"
 	"github.com/conductorone/baton-sdk/pkg/logging"
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 	"github.com/spf13/cobra"
 	"github.com/xuri/excelize/v2"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
 )
 
+const (
+	xlsxSheetSummary      = "Summary"
+	xlsxSheetIdentities   = "Identities"
+	xlsxSheetResources    = "Resources"
+	xlsxSheetEntitlements = "Entitlements"
+	xlsxSheetGrants       = "Grants"
+
+	// xlsxDateFormat is the built-in "yyyy-mm-dd h:mm" number format.
+	xlsxDateFormat = 22
+)
+
 func exportXLSX() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "xlsx",
@@ -22,204 +35,325 @@ func exportXLSX() *cobra.Command {
 	}
 
 	cmd.Flags().String("out", "./sync.xlsx", "The path to export the XLSX to")
+	addExportFlags(cmd)
 
 	return cmd
 }
 
-type excelRow struct {
-	rowType                string
-	lastName               string
-	firstName              string
-	userID                 string
-	userStatus             string
-	emailAddress           string
-	entitlementDisplayName string
-	entitlement            string
-	resourceType           string
-	resourceName           string
-	entitlementDescription string
-	entitlementSlug        string
+type xlsxColumn struct {
+	name  string
+	width float64
+	date  bool
 }
 
-func (c excelRow) Row(sheet string, row int, f *excelize.File) error {
-	for i := header(1); i < headerTerminator; i++ {
-		switch i {
-		case headerType:
-			cell, err := excelize.CoordinatesToCellName(int(i), row)
-			if err != nil {
-				return err
-			}
-			err = f.SetCellValue(sheet, cell, c.rowType)
-			if err != nil {
-				return err
-			}
+// xlsxSheet writes rows to a worksheet with a frozen, filterable header row.
+type xlsxSheet struct {
+	f       *excelize.File
+	name    string
+	columns []xlsxColumn
+	row     int
+}
 
-		case headerLastName:
-			cell, err := excelize.CoordinatesToCellName(int(i), row)
-			if err != nil {
-				return err
-			}
-			err = f.SetCellValue(sheet, cell, c.lastName)
-			if err != nil {
-				return err
-			}
-		case headerFirstName:
-			cell, err := excelize.CoordinatesToCellName(int(i), row)
-			if err != nil {
-				return err
-			}
-			err = f.SetCellValue(sheet, cell, c.firstName)
-			if err != nil {
-				return err
-			}
-		case headerUserID:
-			cell, err := excelize.CoordinatesToCellName(int(i), row)
-			if err != nil {
-				return err
-			}
-			err = f.SetCellValue(sheet, cell, c.userID)
-			if err != nil {
-				return err
-			}
-		case headerUserStatus:
-			cell, err := excelize.CoordinatesToCellName(int(i), row)
-			if err != nil {
-				return err
-			}
-			err = f.SetCellValue(sheet, cell, c.userStatus)
-			if err != nil {
-				return err
-			}
-		case headerEmailAddress:
-			cell, err := excelize.CoordinatesToCellName(int(i), row)
-			if err != nil {
-				return err
-			}
-			err = f.SetCellValue(sheet, cell, c.emailAddress)
-			if err != nil {
-				return err
-			}
-		case headerEntitlementDisplay:
-			cell, err := excelize.CoordinatesToCellName(int(i), row)
-			if err != nil {
-				return err
-			}
-			err = f.SetCellValue(sheet, cell, c.entitlementDisplayName)
-			if err != nil {
-				return err
-			}
-		case headerEntitlement:
-			cell, err := excelize.CoordinatesToCellName(int(i), row)
-			if err != nil {
-				return err
-			}
-			err = f.SetCellValue(sheet, cell, c.entitlement)
-			if err != nil {
-				return err
-			}
-		case headerResourceType:
-			cell, err := excelize.CoordinatesToCellName(int(i), row)
-			if err != nil {
-				return err
-			}
-			err = f.SetCellValue(sheet, cell, c.resourceType)
-			if err != nil {
-				return err
-			}
-		case headerResourceName:
-			cell, err := excelize.CoordinatesToCellName(int(i), row)
-			if err != nil {
-				return err
-			}
-			err = f.SetCellValue(sheet, cell, c.resourceName)
-			if err != nil {
-				return err
-			}
-		case headerEntitlementDescription:
-			cell, err := excelize.CoordinatesToCellName(int(i), row)
+func newXLSXSheet(f *excelize.File, name string, headerStyle int, dateStyle int, columns []xlsxColumn) (*xlsxSheet, error) {
+	_, err := f.NewSheet(name)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &xlsxSheet{
+		f:       f,
+		name:    name,
+		columns: columns,
+		row:     1,
+	}
+
+	header := make([]interface{}, 0, len(columns))
+	for i, c := range columns {
+		header = append(header, c.name)
+
+		col, err := excelize.ColumnNumberToName(i + 1)
+		if err != nil {
+			return nil, err
+		}
+		if c.width > 0 {
+			err = f.SetColWidth(name, col, col, c.width)
 			if err != nil {
-				return err
+				return nil, err
 			}
-			err = f.SetCellValue(sheet, cell, c.entitlementDescription)
+		}
+		if c.date {
+			err = f.SetColStyle(name, col, dateStyle)
 			if err != nil {
-				return err
+				return nil, err
 			}
+		}
+	}
 
-		case headerEntitlementSlug:
-			cell, err := excelize.CoordinatesToCellName(int(i), row)
-			if err != nil {
-				return err
-			}
+	_, err = s.add(header...)
+	if err != nil {
+		return nil, err
+	}
 
-			err = f.SetCellValue(sheet, cell, c.entitlementSlug)
-			if err != nil {
-				return err
-			}
+	lastCell, err := excelize.CoordinatesToCellName(len(columns), 1)
+	if err != nil {
+		return nil, err
+	}
+	err = f.SetCellStyle(name, "A1", lastCell, headerStyle)
+	if err != nil {
+		return nil, err
+	}
 
-		default:
-			return fmt.Errorf("unexpected header")
-		}
+	err = f.SetPanes(name, &excelize.Panes{
+		Freeze:      true,
+		YSplit:      1,
+		TopLeftCell: "A2",
+		ActivePane:  "bottomLeft",
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return s, nil
 }
 
-func runExportXLSX(cmd *cobra.Command, args []string) error {
-	ctx, err := logging.Init(context.Background(), logging.WithLogFormat("console"), logging.WithLogLevel("error"))
+// add writes a row and returns its row number. Values keep their types, so numbers, booleans and times are typed cells.
+func (s *xlsxSheet) add(values ...interface{}) (int, error) {
+	cell, err := excelize.CoordinatesToCellName(1, s.row)
 	if err != nil {
-		return err
+		return 0, err
 	}
-	c1zPath, err := cmd.Flags().GetString("file")
+
+	err = s.f.SetSheetRow(s.name, cell, &values)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	outPath, err := cmd.Flags().GetString("out")
+	row := s.row
+	s.row++
+
+	return row, nil
+}
+
+// link turns a cell into a hyperlink. Links to other sheets use the "Sheet!A1" form and are marked as locations.
+func (s *xlsxSheet) link(col int, row int, target string) error {
+	if target == "" {
+		return nil
+	}
+
+	cell, err := excelize.CoordinatesToCellName(col, row)
 	if err != nil {
 		return err
 	}
 
-	m, err := manager.New(ctx, c1zPath)
+	linkType := "External"
+	if !strings.Contains(target, "://") {
+		linkType = "Location"
+	}
+
+	return s.f.SetCellHyperLink(s.name, cell, target, linkType)
+}
+
+// finish adds an autofilter over the header and every written row.
+func (s *xlsxSheet) finish() error {
+	lastCell, err := excelize.CoordinatesToCellName(len(s.columns), max(s.row-1, 1))
 	if err != nil {
 		return err
 	}
-	defer m.Close(ctx)
 
-	store, err := m.LoadC1Z(ctx)
+	return s.f.AutoFilter(s.name, "A1:"+lastCell, nil)
+}
+
+func xlsxRowRef(sheet string, row int) string {
+	if row == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%s!A%d", sheet, row)
+}
+
+func xlsxTime(ts *timestamppb.Timestamp) interface{} {
+	if ts == nil {
+		return nil
+	}
+
+	return ts.AsTime().UTC()
+}
+
+// externalLink returns the URL of the resource's ExternalLink annotation, if it has one.
+func externalLink(r *v2.Resource) (string, error) {
+	link := &v2.ExternalLink{}
+	annos := annotations.Annotations(r.Annotations)
+	ok, err := annos.Pick(link)
 	if err != nil {
-		return err
+		return "", err
+	}
+	if !ok {
+		return "", nil
 	}
 
-	resourceTypes, err := fetchResourceTypes(ctx, store)
+	return link.Url, nil
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func runExportXLSX(cmd *cobra.Command, args []string) error {
+	ctx, err := logging.Init(context.Background(), logging.WithLogFormat("console"), logging.WithLogLevel("error"))
 	if err != nil {
 		return err
 	}
-	resourcesByID, resourcesByType, err := fetchResources(ctx, store)
+
+	outPath, err := cmd.Flags().GetString("out")
 	if err != nil {
 		return err
 	}
-	entitlementsByID, entitlementsByResource, err := fetchEntitlements(ctx, store)
+
+	d, err := loadExportData(ctx, cmd)
 	if err != nil {
 		return err
 	}
-	grantsByID, grantsByPrincipal, err := fetchGrants(ctx, store)
+
+	err = buildXLSX(ctx, d, outPath)
 	if err != nil {
 		return err
 	}
 
-	d := dataBag{
-		resourceTypes:      resourceTypes,
-		resourcesByID:      resourcesByID,
-		resourcesByType:    resourcesByType,
-		entitlementsByID:   entitlementsByID,
-		entitlementsByType: entitlementsByResource,
-		grantsByID:         grantsByID,
-		grantsByType:       grantsByPrincipal,
+	return nil
+}
+
+// xlsxSheets holds the sheets of the export workbook.
+type xlsxSheets struct {
+	summary      *xlsxSheet
+	identities   *xlsxSheet
+	resources    *xlsxSheet
+	entitlements *xlsxSheet
+	grants       *xlsxSheet
+}
+
+func newXLSXSheets(f *excelize.File) (*xlsxSheets, error) {
+	headerStyle, err := f.NewStyle(&excelize.Style{
+		Font: &excelize.Font{Bold: true},
+		Fill: excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{"D9E1F2"}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dateStyle, err := f.NewStyle(&excelize.Style{NumFmt: xlsxDateFormat})
+	if err != nil {
+		return nil, err
 	}
 
-	err = buildXLSX(ctx, d, outPath)
+	ret := &xlsxSheets{}
+	ret.summary, err = newXLSXSheet(f, xlsxSheetSummary, headerStyle, dateStyle, []xlsxColumn{
+		{name: "Resource Type", width: 30},
+		{name: "Resource Type ID", width: 24},
+		{name: "Traits", width: 24},
+		{name: "Resources", width: 12},
+		{name: "Identities", width: 12},
+		{name: "Entitlements", width: 14},
+		{name: "Grants", width: 12},
+	})
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	ret.identities, err = newXLSXSheet(f, xlsxSheetIdentities, headerStyle, dateStyle, []xlsxColumn{
+		{name: "Identity ID", width: 36},
+		{name: "Resource Type", width: 20},
+		{name: "Principal Type", width: 14},
+		{name: "Display Name", width: 30},
+		{name: "Last Name", width: 20},
+		{name: "First Name", width: 20},
+		{name: "User ID", width: 20},
+		{name: "Email Address", width: 36},
+		{name: "Login", width: 24},
+		{name: "User Status", width: 12},
+		{name: "Account Type", width: 16},
+		{name: "Last Login", width: 18, date: true},
+		{name: "Created At", width: 18, date: true},
+		{name: "Grants", width: 10},
+		{name: "Details", width: 40},
+		{name: "Link", width: 40},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ret.resources, err = newXLSXSheet(f, xlsxSheetResources, headerStyle, dateStyle, []xlsxColumn{
+		{name: "Resource ID", width: 36},
+		{name: "Resource Type", width: 20},
+		{name: "Display Name", width: 30},
+		{name: "Description", width: 40},
+		{name: "Parent Resource ID", width: 36},
+		{name: "Parent Resource", width: 30},
+		{name: "Entitlements", width: 14},
+		{name: "Link", width: 40},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ret.entitlements, err = newXLSXSheet(f, xlsxSheetEntitlements, headerStyle, dateStyle, []xlsxColumn{
+		{name: "Entitlement ID", width: 40},
+		{name: "Entitlement Display Name", width: 30},
+		{name: "Entitlement Slug", width: 20},
+		{name: "Entitlement Description", width: 40},
+		{name: "Purpose", width: 20},
+		{name: "Resource Type", width: 20},
+		{name: "Resource ID", width: 36},
+		{name: "Resource Name", width: 30},
+		{name: "Grants", width: 10},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ret.grants, err = newXLSXSheet(f, xlsxSheetGrants, headerStyle, dateStyle, []xlsxColumn{
+		{name: "Grant ID", width: 40},
+		{name: "Identity ID", width: 36},
+		{name: "Principal Type", width: 14},
+		{name: "Identity", width: 30},
+		{name: "Email Address", width: 36},
+		{name: "Identity Row", width: 14},
+		{name: "Entitlement ID", width: 40},
+		{name: "Entitlement Display Name", width: 30},
+		{name: "Entitlement Row", width: 16},
+		{name: "Resource Type", width: 20},
+		{name: "Resource Name", width: 30},
+		{name: "Inherited From", width: 40},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Drop the default sheet now that the workbook has others, and open on the summary.
+	err = f.DeleteSheet("Sheet1")
+	if err != nil {
+		return nil, err
+	}
+	idx, err := f.GetSheetIndex(xlsxSheetSummary)
+	if err != nil {
+		return nil, err
+	}
+	f.SetActiveSheet(idx)
+
+	return ret, nil
+}
+
+func (s *xlsxSheets) finish() error {
+	for _, sheet := range []*xlsxSheet{s.summary, s.identities, s.resources, s.entitlements, s.grants} {
+		err := sheet.finish()
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -230,145 +364,243 @@ func buildXLSX(ctx context.Context, d dataBag, outPath string) error {
 	l.Debug("building XLSX")
 
 	f := excelize.NewFile()
-	sheet := "Sheet1"
-	row := 1
+	defer f.Close()
+
+	sheets, err := newXLSXSheets(f)
+	if err != nil {
+		return err
+	}
+
+	// Count grants per entitlement and per principal so each row can show how much access it accounts for.
+	entitlementGrants := make(map[string]int)
+	principalGrants := make(map[string]int)
+	for _, g := range d.grantsByID {
+		if g.GetEntitlement().GetId() == "" || g.GetPrincipal().GetId() == nil {
+			continue
+		}
+		entitlementGrants[g.Entitlement.Id]++
+		principalGrants[fmtResourceID(g.Principal.Id)]++
+	}
+
+	// Identities, and the other principals such as groups, apps and secrets
+	principals, err := d.principals()
+	if err != nil {
+		return err
+	}
 
-	for ii, h := range headers() {
-		cell, err := excelize.CoordinatesToCellName(ii+1, row)
+	identityRows := make(map[string]int)
+	principalsByID := make(map[string]*exportPrincipal)
+	for _, p := range principals {
+		link, err := externalLink(p.resource)
 		if err != nil {
 			return err
 		}
 
-		err = f.SetCellValue(sheet, cell, h)
+		ut := p.user
+		profile := ut.GetProfile().GetFields()
+		var status, accountType string
+		if ut != nil {
+			status = getUserStatus(ctx, ut)
+			accountType = ut.AccountType.String()
+		}
+
+		row, err := sheets.identities.add(
+			p.id,
+			p.resource.Id.ResourceType,
+			p.principalType,
+			p.resource.DisplayName,
+			profile["last_name"].GetStringValue(),
+			profile["first_name"].GetStringValue(),
+			profile["user_id"].GetStringValue(),
+			primaryEmail(ut),
+			ut.GetLogin(),
+			status,
+			accountType,
+			xlsxTime(ut.GetLastLogin()),
+			xlsxTime(ut.GetCreatedAt()),
+			principalGrants[p.id],
+			p.details,
+			link,
+		)
+		if err != nil {
+			return err
+		}
+		err = sheets.identities.link(len(sheets.identities.columns), row, link)
 		if err != nil {
 			return err
 		}
+
+		identityRows[p.id] = row
+		principalsByID[p.id] = p
 	}
-	row++
 
-	// Identity
-	var userTypes []string
-	for _, rt := range d.resourceTypes {
-		for _, t := range rt.Traits {
-			if t == v2.ResourceType_TRAIT_USER {
-				userTypes = append(userTypes, rt.Id)
+	// Resources
+	for _, id := range sortedKeys(d.resourcesByID) {
+		r := d.resourcesByID[id]
+
+		var parentID, parentName string
+		if r.ParentResourceId != nil {
+			parentID = fmtResourceID(r.ParentResourceId)
+			if parent, ok := d.resourcesByID[parentID]; ok {
+				parentName = parent.DisplayName
 			}
 		}
-	}
 
-	for _, userType := range userTypes {
-		var ok bool
-		var rs map[string]*v2.Resource
+		link, err := externalLink(r)
+		if err != nil {
+			return err
+		}
 
-		rs, ok = d.resourcesByType[userType]
-		if !ok {
-			continue
+		row, err := sheets.resources.add(
+			id,
+			r.Id.ResourceType,
+			r.DisplayName,
+			r.Description,
+			parentID,
+			parentName,
+			len(d.entitlementsByType[id]),
+			link,
+		)
+		if err != nil {
+			return err
+		}
+		err = sheets.resources.link(len(sheets.resources.columns), row, link)
+		if err != nil {
+			return err
 		}
+	}
 
-		for _, r := range rs {
-			ut := &v2.UserTrait{}
-			annos := annotations.Annotations(r.Annotations)
-			ok, err := annos.Pick(ut)
-			if err != nil {
-				return err
-			}
-			if !ok {
-				continue
-			}
+	// Entitlements
+	entitlementRows := make(map[string]int)
+	for _, id := range sortedKeys(d.entitlementsByID) {
+		e := d.entitlementsByID[id]
 
-			var emailAddress string
-			for _, e := range ut.Emails {
-				if e.IsPrimary {
-					emailAddress = e.Address
-					break
-				}
-			}
+		var resourceID string
+		if rID := e.GetResource().GetId(); rID != nil {
+			resourceID = fmtResourceID(rID)
+		}
 
-			profile := ut.Profile.Fields
-			er := excelRow{
-				rowType:      "Identity",
-				lastName:     profile["last_name"].GetStringValue(),
-				firstName:    profile["first_name"].GetStringValue(),
-				userID:       profile["user_id"].GetStringValue(),
-				userStatus:   getUserStatus(ctx, ut),
-				emailAddress: emailAddress,
-			}
+		row, err := sheets.entitlements.add(
+			e.Id,
+			e.DisplayName,
+			e.Slug,
+			e.Description,
+			e.Purpose.String(),
+			e.GetResource().GetId().GetResourceType(),
+			resourceID,
+			e.GetResource().GetDisplayName(),
+			entitlementGrants[e.Id],
+		)
+		if err != nil {
+			return err
+		}
+		entitlementRows[e.Id] = row
+	}
 
-			err = er.Row(sheet, row, f)
-			if err != nil {
-				return err
-			}
-			row++
+	// Grants
+	addGrant := func(id string, principalID string, e *v2.Entitlement, inheritedFrom string) error {
+		p := principalsByID[principalID]
+		identityRow := identityRows[principalID]
+		entitlementRow := entitlementRows[e.Id]
+		row, err := sheets.grants.add(
+			id,
+			principalID,
+			p.principalType,
+			p.resource.DisplayName,
+			primaryEmail(p.user),
+			identityRow,
+			e.Id,
+			e.DisplayName,
+			entitlementRow,
+			e.GetResource().GetId().GetResourceType(),
+			e.GetResource().GetDisplayName(),
+			inheritedFrom,
+		)
+		if err != nil {
+			return err
 		}
+
+		err = sheets.grants.link(6, row, xlsxRowRef(xlsxSheetIdentities, identityRow))
+		if err != nil {
+			return err
+		}
+
+		return sheets.grants.link(9, row, xlsxRowRef(xlsxSheetEntitlements, entitlementRow))
 	}
 
-	for _, e := range d.entitlementsByID {
-		er := excelRow{
-			rowType:                "Entitlement",
-			entitlementDisplayName: e.DisplayName,
-			entitlement:            e.Id,
-			resourceType:           e.Resource.Id.ResourceType,
-			resourceName:           e.Resource.DisplayName,
-			entitlementDescription: e.Description,
-			entitlementSlug:        e.Slug,
+	for _, id := range sortedKeys(d.grantsByID) {
+		g := d.grantsByID[id]
+		if g.GetEntitlement().GetId() == "" || g.GetPrincipal().GetId() == nil {
+			continue
 		}
 
-		err := er.Row(sheet, row, f)
+		var e *v2.Entitlement
+		if en, ok := d.entitlementsByID[g.Entitlement.Id]; ok {
+			e = en
+		} else {
+			e = g.Entitlement
+		}
+
+		err := addGrant(g.Id, fmtResourceID(g.Principal.Id), e, "")
 		if err != nil {
 			return err
 		}
-		row++
 	}
 
-	for _, g := range d.grantsByID {
-		if p, ok := d.resourcesByID[fmtResourceID(g.Principal.Id)]; ok {
-			ut := &v2.UserTrait{}
+	for _, ig := range d.inheritedGrants {
+		err := addGrant("", fmtResourceID(ig.principal), ig.entitlement, strings.Join(ig.via, ";"))
+		if err != nil {
+			return err
+		}
+	}
 
-			annos := annotations.Annotations(p.Annotations)
-			ok, err := annos.Pick(ut)
-			if err != nil {
-				return err
-			}
-			if !ok {
-				continue
-			}
+	// Summary
+	var totalResources, totalIdentities, totalEntitlements, totalGrants int
+	for _, rtID := range sortedKeys(d.resourceTypes) {
+		rt := d.resourceTypes[rtID]
 
-			var emailAddress string
-			for _, e := range ut.Emails {
-				if e.IsPrimary {
-					emailAddress = e.Address
-					break
-				}
-			}
-			profile := ut.Profile.Fields
+		var traits []string
+		for _, t := range rt.Traits {
+			traits = append(traits, t.String())
+		}
 
-			var e *v2.Entitlement
-			if en, ok := d.entitlementsByID[g.Entitlement.Id]; ok {
-				e = en
-			} else {
-				e = g.Entitlement
+		var identities, entitlements, grants int
+		for id := range d.resourcesByType[rtID] {
+			if _, ok := identityRows[id]; ok {
+				identities++
 			}
-
-			er := excelRow{
-				rowType:                "Grant",
-				lastName:               profile["last_name"].GetStringValue(),
-				firstName:              profile["first_name"].GetStringValue(),
-				userID:                 profile["user_id"].GetStringValue(),
-				emailAddress:           emailAddress,
-				entitlementDisplayName: e.DisplayName,
-				entitlement:            e.Id,
-				resourceType:           e.Resource.Id.ResourceType,
-				resourceName:           e.Resource.DisplayName,
-				entitlementDescription: e.Description,
-				entitlementSlug:        e.Slug,
+			for enID := range d.entitlementsByType[id] {
+				entitlements++
+				grants += entitlementGrants[enID]
 			}
+		}
 
-			err = er.Row(sheet, row, f)
-			if err != nil {
-				return err
-			}
-			row++
+		_, err := sheets.summary.add(
+			rt.DisplayName,
+			rtID,
+			strings.Join(traits, ", "),
+			len(d.resourcesByType[rtID]),
+			identities,
+			entitlements,
+			grants,
+		)
+		if err != nil {
+			return err
 		}
+
+		totalResources += len(d.resourcesByType[rtID])
+		totalIdentities += identities
+		totalEntitlements += entitlements
+		totalGrants += grants
+	}
+	_, err = sheets.summary.add("Total", "", "", totalResources, totalIdentities, totalEntitlements, totalGrants)
+	if err != nil {
+		return err
+	}
+
+	err = sheets.finish()
+	if err != nil {
+		return err
 	}
 
 	if err := f.SaveAs(outPath); err != nil {
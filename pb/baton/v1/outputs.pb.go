@@ -504,12 +504,13 @@ func (x *GrantOutput) GetPrincipal() *v2.Resource {
 }
 
 type ResourceAccessOutput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ResourceType  *v2.ResourceType       `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
-	Resource      *v2.Resource           `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
-	Entitlements  []*v2.Entitlement      `protobuf:"bytes,3,rep,name=entitlements,proto3" json:"entitlements,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	ResourceType          *v2.ResourceType       `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	Resource              *v2.Resource           `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	Entitlements          []*v2.Entitlement      `protobuf:"bytes,3,rep,name=entitlements,proto3" json:"entitlements,omitempty"`
+	InheritedEntitlements []*v2.Entitlement      `protobuf:"bytes,4,rep,name=inherited_entitlements,json=inheritedEntitlements,proto3" json:"inherited_entitlements,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *ResourceAccessOutput) Reset() {
@@ -563,9 +564,17 @@ func (x *ResourceAccessOutput) GetEntitlements() []*v2.Entitlement {
 	return nil
 }
 
+func (x *ResourceAccessOutput) GetInheritedEntitlements() []*v2.Entitlement {
+	if x != nil {
+		return x.InheritedEntitlements
+	}
+	return nil
+}
+
 type ResourceTypeListOutput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ResourceTypes []*ResourceTypeOutput  `protobuf:"bytes,1,rep,name=resource_types,json=resourceTypes,proto3" json:"resource_types,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -607,9 +616,17 @@ func (x *ResourceTypeListOutput) GetResourceTypes() []*ResourceTypeOutput {
 	return nil
 }
 
+func (x *ResourceTypeListOutput) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type ResourceListOutput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Resources     []*ResourceOutput      `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -651,9 +668,17 @@ func (x *ResourceListOutput) GetResources() []*ResourceOutput {
 	return nil
 }
 
+func (x *ResourceListOutput) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type EntitlementListOutput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Entitlements  []*EntitlementOutput   `protobuf:"bytes,1,rep,name=entitlements,proto3" json:"entitlements,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -695,9 +720,17 @@ func (x *EntitlementListOutput) GetEntitlements() []*EntitlementOutput {
 	return nil
 }
 
+func (x *EntitlementListOutput) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type GrantListOutput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Grants        []*GrantOutput         `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -739,6 +772,13 @@ func (x *GrantListOutput) GetGrants() []*GrantOutput {
 	return nil
 }
 
+func (x *GrantListOutput) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type ResourceAccessListOutput struct {
 	state         protoimpl.MessageState  `protogen:"open.v1"`
 	Principal     *v2.Resource            `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
@@ -791,19 +831,82 @@ func (x *ResourceAccessListOutput) GetAccess() []*ResourceAccessOutput {
 	return nil
 }
 
+type PrincipalMatchOutput struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Base     *ResourceOutput        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Compared *ResourceOutput        `protobuf:"bytes,2,opt,name=compared,proto3" json:"compared,omitempty"`
+	// The match key that matched the principals.
+	MatchKey      string `protobuf:"bytes,3,opt,name=match_key,json=matchKey,proto3" json:"match_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrincipalMatchOutput) Reset() {
+	*x = PrincipalMatchOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrincipalMatchOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrincipalMatchOutput) ProtoMessage() {}
+
+func (x *PrincipalMatchOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrincipalMatchOutput.ProtoReflect.Descriptor instead.
+func (*PrincipalMatchOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PrincipalMatchOutput) GetBase() *ResourceOutput {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *PrincipalMatchOutput) GetCompared() *ResourceOutput {
+	if x != nil {
+		return x.Compared
+	}
+	return nil
+}
+
+func (x *PrincipalMatchOutput) GetMatchKey() string {
+	if x != nil {
+		return x.MatchKey
+	}
+	return ""
+}
+
 type PrincipalsCompareOutput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Missing       []*ResourceOutput      `protobuf:"bytes,1,rep,name=missing,proto3" json:"missing,omitempty"`
-	Extra         []*ResourceOutput      `protobuf:"bytes,2,rep,name=extra,proto3" json:"extra,omitempty"`
-	Base          []*ResourceOutput      `protobuf:"bytes,3,rep,name=base,proto3" json:"base,omitempty"`
-	Compared      []*ResourceOutput      `protobuf:"bytes,4,rep,name=compared,proto3" json:"compared,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Missing       []*ResourceOutput       `protobuf:"bytes,1,rep,name=missing,proto3" json:"missing,omitempty"`
+	Extra         []*ResourceOutput       `protobuf:"bytes,2,rep,name=extra,proto3" json:"extra,omitempty"`
+	Base          []*ResourceOutput       `protobuf:"bytes,3,rep,name=base,proto3" json:"base,omitempty"`
+	Compared      []*ResourceOutput       `protobuf:"bytes,4,rep,name=compared,proto3" json:"compared,omitempty"`
+	Matched       []*PrincipalMatchOutput `protobuf:"bytes,5,rep,name=matched,proto3" json:"matched,omitempty"`
+	MatchKeys     []string                `protobuf:"bytes,6,rep,name=match_keys,json=matchKeys,proto3" json:"match_keys,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PrincipalsCompareOutput) Reset() {
 	*x = PrincipalsCompareOutput{}
-	mi := &file_baton_v1_outputs_proto_msgTypes[14]
+	mi := &file_baton_v1_outputs_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -815,7 +918,7 @@ func (x *PrincipalsCompareOutput) String() string {
 func (*PrincipalsCompareOutput) ProtoMessage() {}
 
 func (x *PrincipalsCompareOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_baton_v1_outputs_proto_msgTypes[14]
+	mi := &file_baton_v1_outputs_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -828,7 +931,7 @@ func (x *PrincipalsCompareOutput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrincipalsCompareOutput.ProtoReflect.Descriptor instead.
 func (*PrincipalsCompareOutput) Descriptor() ([]byte, []int) {
-	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{14}
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *PrincipalsCompareOutput) GetMissing() []*ResourceOutput {
@@ -859,6 +962,20 @@ func (x *PrincipalsCompareOutput) GetCompared() []*ResourceOutput {
 	return nil
 }
 
+func (x *PrincipalsCompareOutput) GetMatched() []*PrincipalMatchOutput {
+	if x != nil {
+		return x.Matched
+	}
+	return nil
+}
+
+func (x *PrincipalsCompareOutput) GetMatchKeys() []string {
+	if x != nil {
+		return x.MatchKeys
+	}
+	return nil
+}
+
 type SyncOutput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -873,7 +990,7 @@ type SyncOutput struct {
 
 func (x *SyncOutput) Reset() {
 	*x = SyncOutput{}
-	mi := &file_baton_v1_outputs_proto_msgTypes[15]
+	mi := &file_baton_v1_outputs_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -885,7 +1002,7 @@ func (x *SyncOutput) String() string {
 func (*SyncOutput) ProtoMessage() {}
 
 func (x *SyncOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_baton_v1_outputs_proto_msgTypes[15]
+	mi := &file_baton_v1_outputs_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -898,7 +1015,7 @@ func (x *SyncOutput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncOutput.ProtoReflect.Descriptor instead.
 func (*SyncOutput) Descriptor() ([]byte, []int) {
-	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{15}
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SyncOutput) GetId() string {
@@ -946,13 +1063,14 @@ func (x *SyncOutput) GetParentSyncId() string {
 type SyncListOutput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Syncs         []*SyncOutput          `protobuf:"bytes,1,rep,name=syncs,proto3" json:"syncs,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SyncListOutput) Reset() {
 	*x = SyncListOutput{}
-	mi := &file_baton_v1_outputs_proto_msgTypes[16]
+	mi := &file_baton_v1_outputs_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -964,7 +1082,7 @@ func (x *SyncListOutput) String() string {
 func (*SyncListOutput) ProtoMessage() {}
 
 func (x *SyncListOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_baton_v1_outputs_proto_msgTypes[16]
+	mi := &file_baton_v1_outputs_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -977,7 +1095,7 @@ func (x *SyncListOutput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncListOutput.ProtoReflect.Descriptor instead.
 func (*SyncListOutput) Descriptor() ([]byte, []int) {
-	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{16}
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SyncListOutput) GetSyncs() []*SyncOutput {
@@ -987,194 +1105,4985 @@ func (x *SyncListOutput) GetSyncs() []*SyncOutput {
 	return nil
 }
 
-var File_baton_v1_outputs_proto protoreflect.FileDescriptor
+func (x *SyncListOutput) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
 
-var file_baton_v1_outputs_proto_rawDesc = string([]byte{
-	0x0a, 0x16, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x2f, 0x76, 0x32, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x63, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x2f, 0x76, 0x32, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x63, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xaf, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x44, 0x69, 0x66, 0x66, 0x12, 0x33, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e,
-	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x35,
-	0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
-	0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x6d, 0x6f, 0x64,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0xbb, 0x01, 0x0a, 0x0f, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x66, 0x66, 0x12, 0x36, 0x0a, 0x07, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e,
-	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x12, 0x36, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x08, 0x6d, 0x6f, 0x64,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31,
-	0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66,
-	0x69, 0x65, 0x64, 0x22, 0xa3, 0x01, 0x0a, 0x09, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x44, 0x69, 0x66,
-	0x66, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x07, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52,
-	0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0xb1, 0x01, 0x0a, 0x0d, 0x43, 0x31,
-	0x5a, 0x44, 0x69, 0x66, 0x66, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69,
-	0x66, 0x66, 0x52, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x12, 0x2b, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e,
-	0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x58, 0x0a,
-	0x12, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x4f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x12, 0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x31, 0x2e,
-	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
-	0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x12, 0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x22, 0xce, 0x01, 0x0a, 0x11, 0x45, 0x6e, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x3e,
-	0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35,
-	0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
-	0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63,
-	0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0xaf, 0x02, 0x0a, 0x0b, 0x47, 0x72,
-	0x61, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x67, 0x72, 0x61,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74,
-	0x52, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63,
-	0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45,
-	0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x42,
-	0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x22, 0xd3, 0x01, 0x0a, 0x14,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x12, 0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x31,
-	0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e,
-	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
-	0x40, 0x0a, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x22, 0x5d, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x43, 0x0a, 0x0e, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73,
-	0x22, 0x4c, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x61, 0x74, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x58,
-	0x0a, 0x15, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73,
-	0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x3f, 0x0a, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x0c, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x40, 0x0a, 0x0f, 0x47, 0x72, 0x61, 0x6e,
-	0x74, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x67,
-	0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x61,
-	0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x52, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x18, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73,
-	0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63,
-	0x69, 0x70, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e,
-	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c,
-	0x12, 0x36, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xe1, 0x01, 0x0a, 0x17, 0x50, 0x72, 0x69,
-	0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x4f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52,
-	0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72,
-	0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x12, 0x2c, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72,
-	0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x64, 0x22, 0xf0, 0x01, 0x0a,
-	0x0a, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a,
-	0x0a, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
-	0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x73, 0x79, 0x6e, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x61, 0x72,
-	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x64, 0x22,
-	0x3c, 0x0a, 0x0e, 0x53, 0x79, 0x6e, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x79, 0x6e, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63,
-	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x05, 0x73, 0x79, 0x6e, 0x63, 0x73, 0x42, 0x2f, 0x5a,
-	0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6e, 0x64,
-	0x75, 0x63, 0x74, 0x6f, 0x72, 0x6f, 0x6e, 0x65, 0x2f, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2f, 0x70,
-	0x62, 0x2f, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+type CountOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         uint32                 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountOutput) Reset() {
+	*x = CountOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountOutput) ProtoMessage() {}
+
+func (x *CountOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountOutput.ProtoReflect.Descriptor instead.
+func (*CountOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CountOutput) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CountOutput) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type AccessPathHop struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Entitlement  *v2.Entitlement        `protobuf:"bytes,1,opt,name=entitlement,proto3" json:"entitlement,omitempty"`
+	Resource     *v2.Resource           `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	ResourceType *v2.ResourceType       `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	Direct       bool                   `protobuf:"varint,4,opt,name=direct,proto3" json:"direct,omitempty"`
+	Shallow      bool                   `protobuf:"varint,5,opt,name=shallow,proto3" json:"shallow,omitempty"`
+	// The principal whose grant passed the entitlement on, for inherited hops.
+	Via *v2.Resource `protobuf:"bytes,6,opt,name=via,proto3" json:"via,omitempty"`
+	// Set when the hop was traced from the grant's recorded sources rather than an expansion annotation.
+	FromGrantSources bool `protobuf:"varint,7,opt,name=from_grant_sources,json=fromGrantSources,proto3" json:"from_grant_sources,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AccessPathHop) Reset() {
+	*x = AccessPathHop{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessPathHop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessPathHop) ProtoMessage() {}
+
+func (x *AccessPathHop) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessPathHop.ProtoReflect.Descriptor instead.
+func (*AccessPathHop) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AccessPathHop) GetEntitlement() *v2.Entitlement {
+	if x != nil {
+		return x.Entitlement
+	}
+	return nil
+}
+
+func (x *AccessPathHop) GetResource() *v2.Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *AccessPathHop) GetResourceType() *v2.ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return nil
+}
+
+func (x *AccessPathHop) GetDirect() bool {
+	if x != nil {
+		return x.Direct
+	}
+	return false
+}
+
+func (x *AccessPathHop) GetShallow() bool {
+	if x != nil {
+		return x.Shallow
+	}
+	return false
+}
+
+func (x *AccessPathHop) GetVia() *v2.Resource {
+	if x != nil {
+		return x.Via
+	}
+	return nil
+}
+
+func (x *AccessPathHop) GetFromGrantSources() bool {
+	if x != nil {
+		return x.FromGrantSources
+	}
+	return false
+}
+
+type AccessPath struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hops          []*AccessPathHop       `protobuf:"bytes,1,rep,name=hops,proto3" json:"hops,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessPath) Reset() {
+	*x = AccessPath{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessPath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessPath) ProtoMessage() {}
+
+func (x *AccessPath) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessPath.ProtoReflect.Descriptor instead.
+func (*AccessPath) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AccessPath) GetHops() []*AccessPathHop {
+	if x != nil {
+		return x.Hops
+	}
+	return nil
+}
+
+type AccessExplainOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Principal     *v2.Resource           `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+	Entitlement   *v2.Entitlement        `protobuf:"bytes,2,opt,name=entitlement,proto3" json:"entitlement,omitempty"`
+	Paths         []*AccessPath          `protobuf:"bytes,3,rep,name=paths,proto3" json:"paths,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessExplainOutput) Reset() {
+	*x = AccessExplainOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessExplainOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessExplainOutput) ProtoMessage() {}
+
+func (x *AccessExplainOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessExplainOutput.ProtoReflect.Descriptor instead.
+func (*AccessExplainOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AccessExplainOutput) GetPrincipal() *v2.Resource {
+	if x != nil {
+		return x.Principal
+	}
+	return nil
+}
+
+func (x *AccessExplainOutput) GetEntitlement() *v2.Entitlement {
+	if x != nil {
+		return x.Entitlement
+	}
+	return nil
+}
+
+func (x *AccessExplainOutput) GetPaths() []*AccessPath {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+type AccessHolderOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Principal     *v2.Resource           `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+	PrincipalType *v2.ResourceType       `protobuf:"bytes,2,opt,name=principal_type,json=principalType,proto3" json:"principal_type,omitempty"`
+	Direct        bool                   `protobuf:"varint,3,opt,name=direct,proto3" json:"direct,omitempty"`
+	// The groups whose membership passed the entitlement on to the principal.
+	ViaGroups     []*v2.Resource `protobuf:"bytes,4,rep,name=via_groups,json=viaGroups,proto3" json:"via_groups,omitempty"`
+	Paths         []*AccessPath  `protobuf:"bytes,5,rep,name=paths,proto3" json:"paths,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessHolderOutput) Reset() {
+	*x = AccessHolderOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessHolderOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessHolderOutput) ProtoMessage() {}
+
+func (x *AccessHolderOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessHolderOutput.ProtoReflect.Descriptor instead.
+func (*AccessHolderOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AccessHolderOutput) GetPrincipal() *v2.Resource {
+	if x != nil {
+		return x.Principal
+	}
+	return nil
+}
+
+func (x *AccessHolderOutput) GetPrincipalType() *v2.ResourceType {
+	if x != nil {
+		return x.PrincipalType
+	}
+	return nil
+}
+
+func (x *AccessHolderOutput) GetDirect() bool {
+	if x != nil {
+		return x.Direct
+	}
+	return false
+}
+
+func (x *AccessHolderOutput) GetViaGroups() []*v2.Resource {
+	if x != nil {
+		return x.ViaGroups
+	}
+	return nil
+}
+
+func (x *AccessHolderOutput) GetPaths() []*AccessPath {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+type EntitlementHoldersOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entitlement   *v2.Entitlement        `protobuf:"bytes,1,opt,name=entitlement,proto3" json:"entitlement,omitempty"`
+	Holders       []*AccessHolderOutput  `protobuf:"bytes,2,rep,name=holders,proto3" json:"holders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntitlementHoldersOutput) Reset() {
+	*x = EntitlementHoldersOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntitlementHoldersOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntitlementHoldersOutput) ProtoMessage() {}
+
+func (x *EntitlementHoldersOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntitlementHoldersOutput.ProtoReflect.Descriptor instead.
+func (*EntitlementHoldersOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *EntitlementHoldersOutput) GetEntitlement() *v2.Entitlement {
+	if x != nil {
+		return x.Entitlement
+	}
+	return nil
+}
+
+func (x *EntitlementHoldersOutput) GetHolders() []*AccessHolderOutput {
+	if x != nil {
+		return x.Holders
+	}
+	return nil
+}
+
+type WhoCanAccessOutput struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Resource      *v2.Resource                `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	ResourceType  *v2.ResourceType            `protobuf:"bytes,2,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	Entitlements  []*EntitlementHoldersOutput `protobuf:"bytes,3,rep,name=entitlements,proto3" json:"entitlements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WhoCanAccessOutput) Reset() {
+	*x = WhoCanAccessOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WhoCanAccessOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhoCanAccessOutput) ProtoMessage() {}
+
+func (x *WhoCanAccessOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhoCanAccessOutput.ProtoReflect.Descriptor instead.
+func (*WhoCanAccessOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *WhoCanAccessOutput) GetResource() *v2.Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *WhoCanAccessOutput) GetResourceType() *v2.ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return nil
+}
+
+func (x *WhoCanAccessOutput) GetEntitlements() []*EntitlementHoldersOutput {
+	if x != nil {
+		return x.Entitlements
+	}
+	return nil
+}
+
+type SodGrantOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	File          string                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Conflict      string                 `protobuf:"bytes,2,opt,name=conflict,proto3" json:"conflict,omitempty"`
+	Principal     *v2.Resource           `protobuf:"bytes,3,opt,name=principal,proto3" json:"principal,omitempty"`
+	Entitlement   *v2.Entitlement        `protobuf:"bytes,4,opt,name=entitlement,proto3" json:"entitlement,omitempty"`
+	Resource      *v2.Resource           `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"`
+	Direct        bool                   `protobuf:"varint,6,opt,name=direct,proto3" json:"direct,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SodGrantOutput) Reset() {
+	*x = SodGrantOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SodGrantOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SodGrantOutput) ProtoMessage() {}
+
+func (x *SodGrantOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SodGrantOutput.ProtoReflect.Descriptor instead.
+func (*SodGrantOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SodGrantOutput) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *SodGrantOutput) GetConflict() string {
+	if x != nil {
+		return x.Conflict
+	}
+	return ""
+}
+
+func (x *SodGrantOutput) GetPrincipal() *v2.Resource {
+	if x != nil {
+		return x.Principal
+	}
+	return nil
+}
+
+func (x *SodGrantOutput) GetEntitlement() *v2.Entitlement {
+	if x != nil {
+		return x.Entitlement
+	}
+	return nil
+}
+
+func (x *SodGrantOutput) GetResource() *v2.Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *SodGrantOutput) GetDirect() bool {
+	if x != nil {
+		return x.Direct
+	}
+	return false
+}
+
+type SodViolationOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          string                 `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Severity      string                 `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`
+	Identity      string                 `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Grants        []*SodGrantOutput      `protobuf:"bytes,6,rep,name=grants,proto3" json:"grants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SodViolationOutput) Reset() {
+	*x = SodViolationOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SodViolationOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SodViolationOutput) ProtoMessage() {}
+
+func (x *SodViolationOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SodViolationOutput.ProtoReflect.Descriptor instead.
+func (*SodViolationOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SodViolationOutput) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *SodViolationOutput) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SodViolationOutput) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *SodViolationOutput) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *SodViolationOutput) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *SodViolationOutput) GetGrants() []*SodGrantOutput {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+type SodCheckOutput struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Files               []string               `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	RulesEvaluated      uint32                 `protobuf:"varint,2,opt,name=rules_evaluated,json=rulesEvaluated,proto3" json:"rules_evaluated,omitempty"`
+	IdentitiesEvaluated uint32                 `protobuf:"varint,3,opt,name=identities_evaluated,json=identitiesEvaluated,proto3" json:"identities_evaluated,omitempty"`
+	Violations          []*SodViolationOutput  `protobuf:"bytes,4,rep,name=violations,proto3" json:"violations,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SodCheckOutput) Reset() {
+	*x = SodCheckOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SodCheckOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SodCheckOutput) ProtoMessage() {}
+
+func (x *SodCheckOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SodCheckOutput.ProtoReflect.Descriptor instead.
+func (*SodCheckOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SodCheckOutput) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *SodCheckOutput) GetRulesEvaluated() uint32 {
+	if x != nil {
+		return x.RulesEvaluated
+	}
+	return 0
+}
+
+func (x *SodCheckOutput) GetIdentitiesEvaluated() uint32 {
+	if x != nil {
+		return x.IdentitiesEvaluated
+	}
+	return 0
+}
+
+func (x *SodCheckOutput) GetViolations() []*SodViolationOutput {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+type PrivilegedAccessOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	File          string                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Entitlement   *v2.Entitlement        `protobuf:"bytes,2,opt,name=entitlement,proto3" json:"entitlement,omitempty"`
+	Resource      *v2.Resource           `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	ResourceType  *v2.ResourceType       `protobuf:"bytes,4,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	Principal     *v2.Resource           `protobuf:"bytes,5,opt,name=principal,proto3" json:"principal,omitempty"`
+	PrincipalType *v2.ResourceType       `protobuf:"bytes,6,opt,name=principal_type,json=principalType,proto3" json:"principal_type,omitempty"`
+	Email         string                 `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	Mfa           string                 `protobuf:"bytes,9,opt,name=mfa,proto3" json:"mfa,omitempty"`
+	Direct        bool                   `protobuf:"varint,10,opt,name=direct,proto3" json:"direct,omitempty"`
+	Reason        string                 `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrivilegedAccessOutput) Reset() {
+	*x = PrivilegedAccessOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivilegedAccessOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivilegedAccessOutput) ProtoMessage() {}
+
+func (x *PrivilegedAccessOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivilegedAccessOutput.ProtoReflect.Descriptor instead.
+func (*PrivilegedAccessOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PrivilegedAccessOutput) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *PrivilegedAccessOutput) GetEntitlement() *v2.Entitlement {
+	if x != nil {
+		return x.Entitlement
+	}
+	return nil
+}
+
+func (x *PrivilegedAccessOutput) GetResource() *v2.Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *PrivilegedAccessOutput) GetResourceType() *v2.ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return nil
+}
+
+func (x *PrivilegedAccessOutput) GetPrincipal() *v2.Resource {
+	if x != nil {
+		return x.Principal
+	}
+	return nil
+}
+
+func (x *PrivilegedAccessOutput) GetPrincipalType() *v2.ResourceType {
+	if x != nil {
+		return x.PrincipalType
+	}
+	return nil
+}
+
+func (x *PrivilegedAccessOutput) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *PrivilegedAccessOutput) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PrivilegedAccessOutput) GetMfa() string {
+	if x != nil {
+		return x.Mfa
+	}
+	return ""
+}
+
+func (x *PrivilegedAccessOutput) GetDirect() bool {
+	if x != nil {
+		return x.Direct
+	}
+	return false
+}
+
+func (x *PrivilegedAccessOutput) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type PrivilegedReportOutput struct {
+	state                  protoimpl.MessageState    `protogen:"open.v1"`
+	Files                  []string                  `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	PrivilegedEntitlements uint32                    `protobuf:"varint,2,opt,name=privileged_entitlements,json=privilegedEntitlements,proto3" json:"privileged_entitlements,omitempty"`
+	Access                 []*PrivilegedAccessOutput `protobuf:"bytes,3,rep,name=access,proto3" json:"access,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *PrivilegedReportOutput) Reset() {
+	*x = PrivilegedReportOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivilegedReportOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivilegedReportOutput) ProtoMessage() {}
+
+func (x *PrivilegedReportOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivilegedReportOutput.ProtoReflect.Descriptor instead.
+func (*PrivilegedReportOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PrivilegedReportOutput) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *PrivilegedReportOutput) GetPrivilegedEntitlements() uint32 {
+	if x != nil {
+		return x.PrivilegedEntitlements
+	}
+	return 0
+}
+
+func (x *PrivilegedReportOutput) GetAccess() []*PrivilegedAccessOutput {
+	if x != nil {
+		return x.Access
+	}
+	return nil
+}
+
+type DormantUserOutput struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	File         string                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	User         *v2.Resource           `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	ResourceType *v2.ResourceType       `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	Email        string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Status       string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	LastLogin    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_login,json=lastLogin,proto3" json:"last_login,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Days since the last login, or since creation for users who have never logged in.
+	DaysInactive           uint32            `protobuf:"varint,8,opt,name=days_inactive,json=daysInactive,proto3" json:"days_inactive,omitempty"`
+	NeverLoggedIn          bool              `protobuf:"varint,9,opt,name=never_logged_in,json=neverLoggedIn,proto3" json:"never_logged_in,omitempty"`
+	Entitlements           []*v2.Entitlement `protobuf:"bytes,10,rep,name=entitlements,proto3" json:"entitlements,omitempty"`
+	PrivilegedEntitlements []*v2.Entitlement `protobuf:"bytes,11,rep,name=privileged_entitlements,json=privilegedEntitlements,proto3" json:"privileged_entitlements,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DormantUserOutput) Reset() {
+	*x = DormantUserOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DormantUserOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DormantUserOutput) ProtoMessage() {}
+
+func (x *DormantUserOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DormantUserOutput.ProtoReflect.Descriptor instead.
+func (*DormantUserOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DormantUserOutput) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *DormantUserOutput) GetUser() *v2.Resource {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *DormantUserOutput) GetResourceType() *v2.ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return nil
+}
+
+func (x *DormantUserOutput) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *DormantUserOutput) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DormantUserOutput) GetLastLogin() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastLogin
+	}
+	return nil
+}
+
+func (x *DormantUserOutput) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DormantUserOutput) GetDaysInactive() uint32 {
+	if x != nil {
+		return x.DaysInactive
+	}
+	return 0
+}
+
+func (x *DormantUserOutput) GetNeverLoggedIn() bool {
+	if x != nil {
+		return x.NeverLoggedIn
+	}
+	return false
+}
+
+func (x *DormantUserOutput) GetEntitlements() []*v2.Entitlement {
+	if x != nil {
+		return x.Entitlements
+	}
+	return nil
+}
+
+func (x *DormantUserOutput) GetPrivilegedEntitlements() []*v2.Entitlement {
+	if x != nil {
+		return x.PrivilegedEntitlements
+	}
+	return nil
+}
+
+type DormantReportOutput struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Files   []string               `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	Days    uint32                 `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	Dormant []*DormantUserOutput   `protobuf:"bytes,3,rep,name=dormant,proto3" json:"dormant,omitempty"`
+	// Users created within the threshold who have not logged in yet.
+	NewNeverLoggedIn []*DormantUserOutput `protobuf:"bytes,4,rep,name=new_never_logged_in,json=newNeverLoggedIn,proto3" json:"new_never_logged_in,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DormantReportOutput) Reset() {
+	*x = DormantReportOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DormantReportOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DormantReportOutput) ProtoMessage() {}
+
+func (x *DormantReportOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DormantReportOutput.ProtoReflect.Descriptor instead.
+func (*DormantReportOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DormantReportOutput) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *DormantReportOutput) GetDays() uint32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *DormantReportOutput) GetDormant() []*DormantUserOutput {
+	if x != nil {
+		return x.Dormant
+	}
+	return nil
+}
+
+func (x *DormantReportOutput) GetNewNeverLoggedIn() []*DormantUserOutput {
+	if x != nil {
+		return x.NewNeverLoggedIn
+	}
+	return nil
+}
+
+type AuthPostureBreakdown struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	File  string                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// Unset for per-file and overall totals.
+	ResourceType  *v2.ResourceType `protobuf:"bytes,2,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	Users         uint32           `protobuf:"varint,3,opt,name=users,proto3" json:"users,omitempty"`
+	MfaEnabled    uint32           `protobuf:"varint,4,opt,name=mfa_enabled,json=mfaEnabled,proto3" json:"mfa_enabled,omitempty"`
+	MfaDisabled   uint32           `protobuf:"varint,5,opt,name=mfa_disabled,json=mfaDisabled,proto3" json:"mfa_disabled,omitempty"`
+	MfaUnknown    uint32           `protobuf:"varint,6,opt,name=mfa_unknown,json=mfaUnknown,proto3" json:"mfa_unknown,omitempty"`
+	SsoEnabled    uint32           `protobuf:"varint,7,opt,name=sso_enabled,json=ssoEnabled,proto3" json:"sso_enabled,omitempty"`
+	SsoDisabled   uint32           `protobuf:"varint,8,opt,name=sso_disabled,json=ssoDisabled,proto3" json:"sso_disabled,omitempty"`
+	SsoUnknown    uint32           `protobuf:"varint,9,opt,name=sso_unknown,json=ssoUnknown,proto3" json:"sso_unknown,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthPostureBreakdown) Reset() {
+	*x = AuthPostureBreakdown{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthPostureBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthPostureBreakdown) ProtoMessage() {}
+
+func (x *AuthPostureBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthPostureBreakdown.ProtoReflect.Descriptor instead.
+func (*AuthPostureBreakdown) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AuthPostureBreakdown) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *AuthPostureBreakdown) GetResourceType() *v2.ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return nil
+}
+
+func (x *AuthPostureBreakdown) GetUsers() uint32 {
+	if x != nil {
+		return x.Users
+	}
+	return 0
+}
+
+func (x *AuthPostureBreakdown) GetMfaEnabled() uint32 {
+	if x != nil {
+		return x.MfaEnabled
+	}
+	return 0
+}
+
+func (x *AuthPostureBreakdown) GetMfaDisabled() uint32 {
+	if x != nil {
+		return x.MfaDisabled
+	}
+	return 0
+}
+
+func (x *AuthPostureBreakdown) GetMfaUnknown() uint32 {
+	if x != nil {
+		return x.MfaUnknown
+	}
+	return 0
+}
+
+func (x *AuthPostureBreakdown) GetSsoEnabled() uint32 {
+	if x != nil {
+		return x.SsoEnabled
+	}
+	return 0
+}
+
+func (x *AuthPostureBreakdown) GetSsoDisabled() uint32 {
+	if x != nil {
+		return x.SsoDisabled
+	}
+	return 0
+}
+
+func (x *AuthPostureBreakdown) GetSsoUnknown() uint32 {
+	if x != nil {
+		return x.SsoUnknown
+	}
+	return 0
+}
+
+type MfaRiskUserOutput struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	File                   string                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	User                   *v2.Resource           `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	ResourceType           *v2.ResourceType       `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	Email                  string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Status                 string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Mfa                    string                 `protobuf:"bytes,6,opt,name=mfa,proto3" json:"mfa,omitempty"`
+	PrivilegedEntitlements []*v2.Entitlement      `protobuf:"bytes,7,rep,name=privileged_entitlements,json=privilegedEntitlements,proto3" json:"privileged_entitlements,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *MfaRiskUserOutput) Reset() {
+	*x = MfaRiskUserOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MfaRiskUserOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MfaRiskUserOutput) ProtoMessage() {}
+
+func (x *MfaRiskUserOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MfaRiskUserOutput.ProtoReflect.Descriptor instead.
+func (*MfaRiskUserOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *MfaRiskUserOutput) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *MfaRiskUserOutput) GetUser() *v2.Resource {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *MfaRiskUserOutput) GetResourceType() *v2.ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return nil
+}
+
+func (x *MfaRiskUserOutput) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *MfaRiskUserOutput) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MfaRiskUserOutput) GetMfa() string {
+	if x != nil {
+		return x.Mfa
+	}
+	return ""
+}
+
+func (x *MfaRiskUserOutput) GetPrivilegedEntitlements() []*v2.Entitlement {
+	if x != nil {
+		return x.PrivilegedEntitlements
+	}
+	return nil
+}
+
+type AuthPostureReportOutput struct {
+	state                protoimpl.MessageState  `protogen:"open.v1"`
+	Files                []string                `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	Total                *AuthPostureBreakdown   `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	ByFile               []*AuthPostureBreakdown `protobuf:"bytes,3,rep,name=by_file,json=byFile,proto3" json:"by_file,omitempty"`
+	ByResourceType       []*AuthPostureBreakdown `protobuf:"bytes,4,rep,name=by_resource_type,json=byResourceType,proto3" json:"by_resource_type,omitempty"`
+	PrivilegedWithoutMfa []*MfaRiskUserOutput    `protobuf:"bytes,5,rep,name=privileged_without_mfa,json=privilegedWithoutMfa,proto3" json:"privileged_without_mfa,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AuthPostureReportOutput) Reset() {
+	*x = AuthPostureReportOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthPostureReportOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthPostureReportOutput) ProtoMessage() {}
+
+func (x *AuthPostureReportOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthPostureReportOutput.ProtoReflect.Descriptor instead.
+func (*AuthPostureReportOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AuthPostureReportOutput) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *AuthPostureReportOutput) GetTotal() *AuthPostureBreakdown {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *AuthPostureReportOutput) GetByFile() []*AuthPostureBreakdown {
+	if x != nil {
+		return x.ByFile
+	}
+	return nil
+}
+
+func (x *AuthPostureReportOutput) GetByResourceType() []*AuthPostureBreakdown {
+	if x != nil {
+		return x.ByResourceType
+	}
+	return nil
+}
+
+func (x *AuthPostureReportOutput) GetPrivilegedWithoutMfa() []*MfaRiskUserOutput {
+	if x != nil {
+		return x.PrivilegedWithoutMfa
+	}
+	return nil
+}
+
+type OrphanAccountOutput struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	File                  string                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Account               *v2.Resource           `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	ResourceType          *v2.ResourceType       `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	Email                 string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Login                 string                 `protobuf:"bytes,5,opt,name=login,proto3" json:"login,omitempty"`
+	Status                string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	AccountType           string                 `protobuf:"bytes,7,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	Entitlements          []*v2.Entitlement      `protobuf:"bytes,8,rep,name=entitlements,proto3" json:"entitlements,omitempty"`
+	InheritedEntitlements []*v2.Entitlement      `protobuf:"bytes,9,rep,name=inherited_entitlements,json=inheritedEntitlements,proto3" json:"inherited_entitlements,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *OrphanAccountOutput) Reset() {
+	*x = OrphanAccountOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrphanAccountOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrphanAccountOutput) ProtoMessage() {}
+
+func (x *OrphanAccountOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrphanAccountOutput.ProtoReflect.Descriptor instead.
+func (*OrphanAccountOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *OrphanAccountOutput) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *OrphanAccountOutput) GetAccount() *v2.Resource {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *OrphanAccountOutput) GetResourceType() *v2.ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return nil
+}
+
+func (x *OrphanAccountOutput) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *OrphanAccountOutput) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *OrphanAccountOutput) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OrphanAccountOutput) GetAccountType() string {
+	if x != nil {
+		return x.AccountType
+	}
+	return ""
+}
+
+func (x *OrphanAccountOutput) GetEntitlements() []*v2.Entitlement {
+	if x != nil {
+		return x.Entitlements
+	}
+	return nil
+}
+
+func (x *OrphanAccountOutput) GetInheritedEntitlements() []*v2.Entitlement {
+	if x != nil {
+		return x.InheritedEntitlements
+	}
+	return nil
+}
+
+type OrphanAppSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	File          string                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Accounts      uint32                 `protobuf:"varint,2,opt,name=accounts,proto3" json:"accounts,omitempty"`
+	Matched       uint32                 `protobuf:"varint,3,opt,name=matched,proto3" json:"matched,omitempty"`
+	Orphaned      uint32                 `protobuf:"varint,4,opt,name=orphaned,proto3" json:"orphaned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrphanAppSummary) Reset() {
+	*x = OrphanAppSummary{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrphanAppSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrphanAppSummary) ProtoMessage() {}
+
+func (x *OrphanAppSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrphanAppSummary.ProtoReflect.Descriptor instead.
+func (*OrphanAppSummary) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *OrphanAppSummary) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *OrphanAppSummary) GetAccounts() uint32 {
+	if x != nil {
+		return x.Accounts
+	}
+	return 0
+}
+
+func (x *OrphanAppSummary) GetMatched() uint32 {
+	if x != nil {
+		return x.Matched
+	}
+	return 0
+}
+
+func (x *OrphanAppSummary) GetOrphaned() uint32 {
+	if x != nil {
+		return x.Orphaned
+	}
+	return 0
+}
+
+type OrphansOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Idp           string                 `protobuf:"bytes,1,opt,name=idp,proto3" json:"idp,omitempty"`
+	Apps          []string               `protobuf:"bytes,2,rep,name=apps,proto3" json:"apps,omitempty"`
+	MatchKeys     []string               `protobuf:"bytes,3,rep,name=match_keys,json=matchKeys,proto3" json:"match_keys,omitempty"`
+	IdpUsers      uint32                 `protobuf:"varint,4,opt,name=idp_users,json=idpUsers,proto3" json:"idp_users,omitempty"`
+	AppsSummary   []*OrphanAppSummary    `protobuf:"bytes,5,rep,name=apps_summary,json=appsSummary,proto3" json:"apps_summary,omitempty"`
+	Orphans       []*OrphanAccountOutput `protobuf:"bytes,6,rep,name=orphans,proto3" json:"orphans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrphansOutput) Reset() {
+	*x = OrphansOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrphansOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrphansOutput) ProtoMessage() {}
+
+func (x *OrphansOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrphansOutput.ProtoReflect.Descriptor instead.
+func (*OrphansOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *OrphansOutput) GetIdp() string {
+	if x != nil {
+		return x.Idp
+	}
+	return ""
+}
+
+func (x *OrphansOutput) GetApps() []string {
+	if x != nil {
+		return x.Apps
+	}
+	return nil
+}
+
+func (x *OrphansOutput) GetMatchKeys() []string {
+	if x != nil {
+		return x.MatchKeys
+	}
+	return nil
+}
+
+func (x *OrphansOutput) GetIdpUsers() uint32 {
+	if x != nil {
+		return x.IdpUsers
+	}
+	return 0
+}
+
+func (x *OrphansOutput) GetAppsSummary() []*OrphanAppSummary {
+	if x != nil {
+		return x.AppsSummary
+	}
+	return nil
+}
+
+func (x *OrphansOutput) GetOrphans() []*OrphanAccountOutput {
+	if x != nil {
+		return x.Orphans
+	}
+	return nil
+}
+
+type LeaverAccountOutput struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	File         string                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Account      *v2.Resource           `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	ResourceType *v2.ResourceType       `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	Status       string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// The match key that correlated the account with the identity provider user.
+	MatchKey              string            `protobuf:"bytes,5,opt,name=match_key,json=matchKey,proto3" json:"match_key,omitempty"`
+	Entitlements          []*v2.Entitlement `protobuf:"bytes,6,rep,name=entitlements,proto3" json:"entitlements,omitempty"`
+	InheritedEntitlements []*v2.Entitlement `protobuf:"bytes,7,rep,name=inherited_entitlements,json=inheritedEntitlements,proto3" json:"inherited_entitlements,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *LeaverAccountOutput) Reset() {
+	*x = LeaverAccountOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaverAccountOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaverAccountOutput) ProtoMessage() {}
+
+func (x *LeaverAccountOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaverAccountOutput.ProtoReflect.Descriptor instead.
+func (*LeaverAccountOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *LeaverAccountOutput) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *LeaverAccountOutput) GetAccount() *v2.Resource {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *LeaverAccountOutput) GetResourceType() *v2.ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return nil
+}
+
+func (x *LeaverAccountOutput) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *LeaverAccountOutput) GetMatchKey() string {
+	if x != nil {
+		return x.MatchKey
+	}
+	return ""
+}
+
+func (x *LeaverAccountOutput) GetEntitlements() []*v2.Entitlement {
+	if x != nil {
+		return x.Entitlements
+	}
+	return nil
+}
+
+func (x *LeaverAccountOutput) GetInheritedEntitlements() []*v2.Entitlement {
+	if x != nil {
+		return x.InheritedEntitlements
+	}
+	return nil
+}
+
+type LeaverOutput struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	User         *v2.Resource           `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	ResourceType *v2.ResourceType       `protobuf:"bytes,2,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	Email        string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Status       string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// The start of the first sync that saw the user disabled or deleted. Only set when an earlier sync saw them enabled.
+	DisabledAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=disabled_at,json=disabledAt,proto3" json:"disabled_at,omitempty"`
+	// The start of the last sync that saw the user enabled.
+	LastSeenEnabledAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_seen_enabled_at,json=lastSeenEnabledAt,proto3" json:"last_seen_enabled_at,omitempty"`
+	Accounts          []*LeaverAccountOutput `protobuf:"bytes,7,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *LeaverOutput) Reset() {
+	*x = LeaverOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaverOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaverOutput) ProtoMessage() {}
+
+func (x *LeaverOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaverOutput.ProtoReflect.Descriptor instead.
+func (*LeaverOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *LeaverOutput) GetUser() *v2.Resource {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *LeaverOutput) GetResourceType() *v2.ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return nil
+}
+
+func (x *LeaverOutput) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LeaverOutput) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *LeaverOutput) GetDisabledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DisabledAt
+	}
+	return nil
+}
+
+func (x *LeaverOutput) GetLastSeenEnabledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenEnabledAt
+	}
+	return nil
+}
+
+func (x *LeaverOutput) GetAccounts() []*LeaverAccountOutput {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type LeaversOutput struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Idp              string                 `protobuf:"bytes,1,opt,name=idp,proto3" json:"idp,omitempty"`
+	Apps             []string               `protobuf:"bytes,2,rep,name=apps,proto3" json:"apps,omitempty"`
+	MatchKeys        []string               `protobuf:"bytes,3,rep,name=match_keys,json=matchKeys,proto3" json:"match_keys,omitempty"`
+	IdpInactiveUsers uint32                 `protobuf:"varint,4,opt,name=idp_inactive_users,json=idpInactiveUsers,proto3" json:"idp_inactive_users,omitempty"`
+	Leavers          []*LeaverOutput        `protobuf:"bytes,5,rep,name=leavers,proto3" json:"leavers,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LeaversOutput) Reset() {
+	*x = LeaversOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaversOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaversOutput) ProtoMessage() {}
+
+func (x *LeaversOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaversOutput.ProtoReflect.Descriptor instead.
+func (*LeaversOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *LeaversOutput) GetIdp() string {
+	if x != nil {
+		return x.Idp
+	}
+	return ""
+}
+
+func (x *LeaversOutput) GetApps() []string {
+	if x != nil {
+		return x.Apps
+	}
+	return nil
+}
+
+func (x *LeaversOutput) GetMatchKeys() []string {
+	if x != nil {
+		return x.MatchKeys
+	}
+	return nil
+}
+
+func (x *LeaversOutput) GetIdpInactiveUsers() uint32 {
+	if x != nil {
+		return x.IdpInactiveUsers
+	}
+	return 0
+}
+
+func (x *LeaversOutput) GetLeavers() []*LeaverOutput {
+	if x != nil {
+		return x.Leavers
+	}
+	return nil
+}
+
+type SecretOutput struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	File         string                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Secret       *v2.Resource           `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	ResourceType *v2.ResourceType       `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// The identity the secret authenticates as, usually a service account.
+	Identity             *v2.Resource           `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	IdentityResourceType *v2.ResourceType       `protobuf:"bytes,5,opt,name=identity_resource_type,json=identityResourceType,proto3" json:"identity_resource_type,omitempty"`
+	IdentityStatus       string                 `protobuf:"bytes,6,opt,name=identity_status,json=identityStatus,proto3" json:"identity_status,omitempty"`
+	CreatedBy            *v2.Resource           `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt            *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LastUsedAt           *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	Expired              bool                   `protobuf:"varint,11,opt,name=expired,proto3" json:"expired,omitempty"`
+	ExpiringSoon         bool                   `protobuf:"varint,12,opt,name=expiring_soon,json=expiringSoon,proto3" json:"expiring_soon,omitempty"`
+	NeverUsed            bool                   `protobuf:"varint,13,opt,name=never_used,json=neverUsed,proto3" json:"never_used,omitempty"`
+	// The owning identity, or the creator when no identity is set, is missing from the sync or deleted.
+	OwnerDeleted                  bool              `protobuf:"varint,14,opt,name=owner_deleted,json=ownerDeleted,proto3" json:"owner_deleted,omitempty"`
+	IdentityEntitlements          []*v2.Entitlement `protobuf:"bytes,15,rep,name=identity_entitlements,json=identityEntitlements,proto3" json:"identity_entitlements,omitempty"`
+	IdentityInheritedEntitlements []*v2.Entitlement `protobuf:"bytes,16,rep,name=identity_inherited_entitlements,json=identityInheritedEntitlements,proto3" json:"identity_inherited_entitlements,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *SecretOutput) Reset() {
+	*x = SecretOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretOutput) ProtoMessage() {}
+
+func (x *SecretOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretOutput.ProtoReflect.Descriptor instead.
+func (*SecretOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SecretOutput) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *SecretOutput) GetSecret() *v2.Resource {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *SecretOutput) GetResourceType() *v2.ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return nil
+}
+
+func (x *SecretOutput) GetIdentity() *v2.Resource {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+func (x *SecretOutput) GetIdentityResourceType() *v2.ResourceType {
+	if x != nil {
+		return x.IdentityResourceType
+	}
+	return nil
+}
+
+func (x *SecretOutput) GetIdentityStatus() string {
+	if x != nil {
+		return x.IdentityStatus
+	}
+	return ""
+}
+
+func (x *SecretOutput) GetCreatedBy() *v2.Resource {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return nil
+}
+
+func (x *SecretOutput) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SecretOutput) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *SecretOutput) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *SecretOutput) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+func (x *SecretOutput) GetExpiringSoon() bool {
+	if x != nil {
+		return x.ExpiringSoon
+	}
+	return false
+}
+
+func (x *SecretOutput) GetNeverUsed() bool {
+	if x != nil {
+		return x.NeverUsed
+	}
+	return false
+}
+
+func (x *SecretOutput) GetOwnerDeleted() bool {
+	if x != nil {
+		return x.OwnerDeleted
+	}
+	return false
+}
+
+func (x *SecretOutput) GetIdentityEntitlements() []*v2.Entitlement {
+	if x != nil {
+		return x.IdentityEntitlements
+	}
+	return nil
+}
+
+func (x *SecretOutput) GetIdentityInheritedEntitlements() []*v2.Entitlement {
+	if x != nil {
+		return x.IdentityInheritedEntitlements
+	}
+	return nil
+}
+
+type SecretsReportOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Files         []string               `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	ExpiringDays  uint32                 `protobuf:"varint,2,opt,name=expiring_days,json=expiringDays,proto3" json:"expiring_days,omitempty"`
+	Secrets       []*SecretOutput        `protobuf:"bytes,3,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretsReportOutput) Reset() {
+	*x = SecretsReportOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretsReportOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretsReportOutput) ProtoMessage() {}
+
+func (x *SecretsReportOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretsReportOutput.ProtoReflect.Descriptor instead.
+func (*SecretsReportOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SecretsReportOutput) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *SecretsReportOutput) GetExpiringDays() uint32 {
+	if x != nil {
+		return x.ExpiringDays
+	}
+	return 0
+}
+
+func (x *SecretsReportOutput) GetSecrets() []*SecretOutput {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+type ServiceAccountOutput struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	File                   string                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Account                *v2.Resource           `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	ResourceType           *v2.ResourceType       `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	AccountType            string                 `protobuf:"bytes,4,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	Status                 string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt              *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastLogin              *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_login,json=lastLogin,proto3" json:"last_login,omitempty"`
+	Secrets                []*v2.Resource         `protobuf:"bytes,8,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	Entitlements           []*v2.Entitlement      `protobuf:"bytes,9,rep,name=entitlements,proto3" json:"entitlements,omitempty"`
+	InheritedEntitlements  []*v2.Entitlement      `protobuf:"bytes,10,rep,name=inherited_entitlements,json=inheritedEntitlements,proto3" json:"inherited_entitlements,omitempty"`
+	PrivilegedEntitlements []*v2.Entitlement      `protobuf:"bytes,11,rep,name=privileged_entitlements,json=privilegedEntitlements,proto3" json:"privileged_entitlements,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ServiceAccountOutput) Reset() {
+	*x = ServiceAccountOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceAccountOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceAccountOutput) ProtoMessage() {}
+
+func (x *ServiceAccountOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceAccountOutput.ProtoReflect.Descriptor instead.
+func (*ServiceAccountOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ServiceAccountOutput) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *ServiceAccountOutput) GetAccount() *v2.Resource {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *ServiceAccountOutput) GetResourceType() *v2.ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return nil
+}
+
+func (x *ServiceAccountOutput) GetAccountType() string {
+	if x != nil {
+		return x.AccountType
+	}
+	return ""
+}
+
+func (x *ServiceAccountOutput) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ServiceAccountOutput) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ServiceAccountOutput) GetLastLogin() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastLogin
+	}
+	return nil
+}
+
+func (x *ServiceAccountOutput) GetSecrets() []*v2.Resource {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *ServiceAccountOutput) GetEntitlements() []*v2.Entitlement {
+	if x != nil {
+		return x.Entitlements
+	}
+	return nil
+}
+
+func (x *ServiceAccountOutput) GetInheritedEntitlements() []*v2.Entitlement {
+	if x != nil {
+		return x.InheritedEntitlements
+	}
+	return nil
+}
+
+func (x *ServiceAccountOutput) GetPrivilegedEntitlements() []*v2.Entitlement {
+	if x != nil {
+		return x.PrivilegedEntitlements
+	}
+	return nil
+}
+
+type ServiceAccountsReportOutput struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Files           []string                `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	ServiceAccounts []*ServiceAccountOutput `protobuf:"bytes,2,rep,name=service_accounts,json=serviceAccounts,proto3" json:"service_accounts,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ServiceAccountsReportOutput) Reset() {
+	*x = ServiceAccountsReportOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceAccountsReportOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceAccountsReportOutput) ProtoMessage() {}
+
+func (x *ServiceAccountsReportOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceAccountsReportOutput.ProtoReflect.Descriptor instead.
+func (*ServiceAccountsReportOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ServiceAccountsReportOutput) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *ServiceAccountsReportOutput) GetServiceAccounts() []*ServiceAccountOutput {
+	if x != nil {
+		return x.ServiceAccounts
+	}
+	return nil
+}
+
+type InsightTargetOutput struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	File                  string                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Resource              *v2.Resource           `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	ResourceType          *v2.ResourceType       `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	Entitlements          []*v2.Entitlement      `protobuf:"bytes,4,rep,name=entitlements,proto3" json:"entitlements,omitempty"`
+	InheritedEntitlements []*v2.Entitlement      `protobuf:"bytes,5,rep,name=inherited_entitlements,json=inheritedEntitlements,proto3" json:"inherited_entitlements,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *InsightTargetOutput) Reset() {
+	*x = InsightTargetOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsightTargetOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsightTargetOutput) ProtoMessage() {}
+
+func (x *InsightTargetOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsightTargetOutput.ProtoReflect.Descriptor instead.
+func (*InsightTargetOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *InsightTargetOutput) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *InsightTargetOutput) GetResource() *v2.Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *InsightTargetOutput) GetResourceType() *v2.ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return nil
+}
+
+func (x *InsightTargetOutput) GetEntitlements() []*v2.Entitlement {
+	if x != nil {
+		return x.Entitlements
+	}
+	return nil
+}
+
+func (x *InsightTargetOutput) GetInheritedEntitlements() []*v2.Entitlement {
+	if x != nil {
+		return x.InheritedEntitlements
+	}
+	return nil
+}
+
+type InsightOutput struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	File         string                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Insight      *v2.Resource           `protobuf:"bytes,2,opt,name=insight,proto3" json:"insight,omitempty"`
+	ResourceType *v2.ResourceType       `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// Either "risk_score" or "issue".
+	InsightType string `protobuf:"bytes,4,opt,name=insight_type,json=insightType,proto3" json:"insight_type,omitempty"`
+	// The issue, or the score as reported by the source system.
+	Value           string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	NormalizedScore uint32 `protobuf:"varint,6,opt,name=normalized_score,json=normalizedScore,proto3" json:"normalized_score,omitempty"`
+	// One of "critical", "high", "medium", "low" or "unknown".
+	Severity    string                 `protobuf:"bytes,7,opt,name=severity,proto3" json:"severity,omitempty"`
+	RiskFactors []string               `protobuf:"bytes,8,rep,name=risk_factors,json=riskFactors,proto3" json:"risk_factors,omitempty"`
+	ObservedAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=observed_at,json=observedAt,proto3" json:"observed_at,omitempty"`
+	// One of "user", "app_user", "resource" or "external_resource".
+	TargetType string `protobuf:"bytes,10,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	// A description of the target as given by the insight, such as an email address or external ID.
+	Target        string                 `protobuf:"bytes,11,opt,name=target,proto3" json:"target,omitempty"`
+	Targets       []*InsightTargetOutput `protobuf:"bytes,12,rep,name=targets,proto3" json:"targets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsightOutput) Reset() {
+	*x = InsightOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsightOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsightOutput) ProtoMessage() {}
+
+func (x *InsightOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsightOutput.ProtoReflect.Descriptor instead.
+func (*InsightOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *InsightOutput) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *InsightOutput) GetInsight() *v2.Resource {
+	if x != nil {
+		return x.Insight
+	}
+	return nil
+}
+
+func (x *InsightOutput) GetResourceType() *v2.ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return nil
+}
+
+func (x *InsightOutput) GetInsightType() string {
+	if x != nil {
+		return x.InsightType
+	}
+	return ""
+}
+
+func (x *InsightOutput) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *InsightOutput) GetNormalizedScore() uint32 {
+	if x != nil {
+		return x.NormalizedScore
+	}
+	return 0
+}
+
+func (x *InsightOutput) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *InsightOutput) GetRiskFactors() []string {
+	if x != nil {
+		return x.RiskFactors
+	}
+	return nil
+}
+
+func (x *InsightOutput) GetObservedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ObservedAt
+	}
+	return nil
+}
+
+func (x *InsightOutput) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *InsightOutput) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *InsightOutput) GetTargets() []*InsightTargetOutput {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+type InsightsOutput struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Files    []string               `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	Insights []*InsightOutput       `protobuf:"bytes,2,rep,name=insights,proto3" json:"insights,omitempty"`
+	// Insights whose target could not be found in any of the files.
+	Unresolved    uint32 `protobuf:"varint,3,opt,name=unresolved,proto3" json:"unresolved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsightsOutput) Reset() {
+	*x = InsightsOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsightsOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsightsOutput) ProtoMessage() {}
+
+func (x *InsightsOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsightsOutput.ProtoReflect.Descriptor instead.
+func (*InsightsOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *InsightsOutput) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *InsightsOutput) GetInsights() []*InsightOutput {
+	if x != nil {
+		return x.Insights
+	}
+	return nil
+}
+
+func (x *InsightsOutput) GetUnresolved() uint32 {
+	if x != nil {
+		return x.Unresolved
+	}
+	return 0
+}
+
+type OutlierOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	File          string                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	PeerGroup     string                 `protobuf:"bytes,2,opt,name=peer_group,json=peerGroup,proto3" json:"peer_group,omitempty"`
+	PeerGroupSize uint32                 `protobuf:"varint,3,opt,name=peer_group_size,json=peerGroupSize,proto3" json:"peer_group_size,omitempty"`
+	User          *v2.Resource           `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	ResourceType  *v2.ResourceType       `protobuf:"bytes,5,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	Entitlement   *v2.Entitlement        `protobuf:"bytes,6,opt,name=entitlement,proto3" json:"entitlement,omitempty"`
+	// The number of peers, including the user, holding the entitlement.
+	Holders        uint32  `protobuf:"varint,7,opt,name=holders,proto3" json:"holders,omitempty"`
+	HoldersPercent float64 `protobuf:"fixed64,8,opt,name=holders_percent,json=holdersPercent,proto3" json:"holders_percent,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OutlierOutput) Reset() {
+	*x = OutlierOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutlierOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutlierOutput) ProtoMessage() {}
+
+func (x *OutlierOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutlierOutput.ProtoReflect.Descriptor instead.
+func (*OutlierOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *OutlierOutput) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *OutlierOutput) GetPeerGroup() string {
+	if x != nil {
+		return x.PeerGroup
+	}
+	return ""
+}
+
+func (x *OutlierOutput) GetPeerGroupSize() uint32 {
+	if x != nil {
+		return x.PeerGroupSize
+	}
+	return 0
+}
+
+func (x *OutlierOutput) GetUser() *v2.Resource {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *OutlierOutput) GetResourceType() *v2.ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return nil
+}
+
+func (x *OutlierOutput) GetEntitlement() *v2.Entitlement {
+	if x != nil {
+		return x.Entitlement
+	}
+	return nil
+}
+
+func (x *OutlierOutput) GetHolders() uint32 {
+	if x != nil {
+		return x.Holders
+	}
+	return 0
+}
+
+func (x *OutlierOutput) GetHoldersPercent() float64 {
+	if x != nil {
+		return x.HoldersPercent
+	}
+	return 0
+}
+
+type OutliersOutput struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Files      []string               `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	GroupBy    string                 `protobuf:"bytes,2,opt,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	Threshold  float64                `protobuf:"fixed64,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Baseline   float64                `protobuf:"fixed64,4,opt,name=baseline,proto3" json:"baseline,omitempty"`
+	PeerGroups uint32                 `protobuf:"varint,5,opt,name=peer_groups,json=peerGroups,proto3" json:"peer_groups,omitempty"`
+	// Entitlements the user holds that fewer than threshold percent of their peers hold, rarest first.
+	Rare []*OutlierOutput `protobuf:"bytes,6,rep,name=rare,proto3" json:"rare,omitempty"`
+	// Entitlements the user lacks that at least baseline percent of their peers hold, most common first.
+	MissingBaseline []*OutlierOutput `protobuf:"bytes,7,rep,name=missing_baseline,json=missingBaseline,proto3" json:"missing_baseline,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OutliersOutput) Reset() {
+	*x = OutliersOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutliersOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutliersOutput) ProtoMessage() {}
+
+func (x *OutliersOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutliersOutput.ProtoReflect.Descriptor instead.
+func (*OutliersOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *OutliersOutput) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *OutliersOutput) GetGroupBy() string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ""
+}
+
+func (x *OutliersOutput) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *OutliersOutput) GetBaseline() float64 {
+	if x != nil {
+		return x.Baseline
+	}
+	return 0
+}
+
+func (x *OutliersOutput) GetPeerGroups() uint32 {
+	if x != nil {
+		return x.PeerGroups
+	}
+	return 0
+}
+
+func (x *OutliersOutput) GetRare() []*OutlierOutput {
+	if x != nil {
+		return x.Rare
+	}
+	return nil
+}
+
+func (x *OutliersOutput) GetMissingBaseline() []*OutlierOutput {
+	if x != nil {
+		return x.MissingBaseline
+	}
+	return nil
+}
+
+type RoleCandidateUserOutput struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	User  *v2.Resource           `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// Entitlements in scope that the user holds outside of the candidate role.
+	Exceptions    []*v2.Entitlement `protobuf:"bytes,2,rep,name=exceptions,proto3" json:"exceptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleCandidateUserOutput) Reset() {
+	*x = RoleCandidateUserOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleCandidateUserOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleCandidateUserOutput) ProtoMessage() {}
+
+func (x *RoleCandidateUserOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleCandidateUserOutput.ProtoReflect.Descriptor instead.
+func (*RoleCandidateUserOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RoleCandidateUserOutput) GetUser() *v2.Resource {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *RoleCandidateUserOutput) GetExceptions() []*v2.Entitlement {
+	if x != nil {
+		return x.Exceptions
+	}
+	return nil
+}
+
+type RoleCandidateOutput struct {
+	state           protoimpl.MessageState     `protogen:"open.v1"`
+	Entitlements    []*v2.Entitlement          `protobuf:"bytes,1,rep,name=entitlements,proto3" json:"entitlements,omitempty"`
+	UsersCovered    uint32                     `protobuf:"varint,2,opt,name=users_covered,json=usersCovered,proto3" json:"users_covered,omitempty"`
+	CoveragePercent float64                    `protobuf:"fixed64,3,opt,name=coverage_percent,json=coveragePercent,proto3" json:"coverage_percent,omitempty"`
+	Users           []*RoleCandidateUserOutput `protobuf:"bytes,4,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RoleCandidateOutput) Reset() {
+	*x = RoleCandidateOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleCandidateOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleCandidateOutput) ProtoMessage() {}
+
+func (x *RoleCandidateOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleCandidateOutput.ProtoReflect.Descriptor instead.
+func (*RoleCandidateOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RoleCandidateOutput) GetEntitlements() []*v2.Entitlement {
+	if x != nil {
+		return x.Entitlements
+	}
+	return nil
+}
+
+func (x *RoleCandidateOutput) GetUsersCovered() uint32 {
+	if x != nil {
+		return x.UsersCovered
+	}
+	return 0
+}
+
+func (x *RoleCandidateOutput) GetCoveragePercent() float64 {
+	if x != nil {
+		return x.CoveragePercent
+	}
+	return 0
+}
+
+func (x *RoleCandidateOutput) GetUsers() []*RoleCandidateUserOutput {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type RoleMiningOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	File          string                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	ResourceTypes []string               `protobuf:"bytes,2,rep,name=resource_types,json=resourceTypes,proto3" json:"resource_types,omitempty"`
+	MinSupport    float64                `protobuf:"fixed64,3,opt,name=min_support,json=minSupport,proto3" json:"min_support,omitempty"`
+	Users         uint32                 `protobuf:"varint,4,opt,name=users,proto3" json:"users,omitempty"`
+	Candidates    []*RoleCandidateOutput `protobuf:"bytes,5,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleMiningOutput) Reset() {
+	*x = RoleMiningOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleMiningOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleMiningOutput) ProtoMessage() {}
+
+func (x *RoleMiningOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleMiningOutput.ProtoReflect.Descriptor instead.
+func (*RoleMiningOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RoleMiningOutput) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *RoleMiningOutput) GetResourceTypes() []string {
+	if x != nil {
+		return x.ResourceTypes
+	}
+	return nil
+}
+
+func (x *RoleMiningOutput) GetMinSupport() float64 {
+	if x != nil {
+		return x.MinSupport
+	}
+	return 0
+}
+
+func (x *RoleMiningOutput) GetUsers() uint32 {
+	if x != nil {
+		return x.Users
+	}
+	return 0
+}
+
+func (x *RoleMiningOutput) GetCandidates() []*RoleCandidateOutput {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+type ResourceTreeNode struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Resource     *v2.Resource           `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	ResourceType *v2.ResourceType       `protobuf:"bytes,2,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// The child resource types the resource declares, whether or not any children were synced.
+	ChildResourceTypes []string `protobuf:"bytes,3,rep,name=child_resource_types,json=childResourceTypes,proto3" json:"child_resource_types,omitempty"`
+	// Only set when counts are requested.
+	Entitlements uint32 `protobuf:"varint,4,opt,name=entitlements,proto3" json:"entitlements,omitempty"`
+	Grants       uint32 `protobuf:"varint,5,opt,name=grants,proto3" json:"grants,omitempty"`
+	// Set when children were left out because of the depth limit.
+	Truncated     bool                `protobuf:"varint,6,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	Children      []*ResourceTreeNode `protobuf:"bytes,7,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceTreeNode) Reset() {
+	*x = ResourceTreeNode{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceTreeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceTreeNode) ProtoMessage() {}
+
+func (x *ResourceTreeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceTreeNode.ProtoReflect.Descriptor instead.
+func (*ResourceTreeNode) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ResourceTreeNode) GetResource() *v2.Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *ResourceTreeNode) GetResourceType() *v2.ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return nil
+}
+
+func (x *ResourceTreeNode) GetChildResourceTypes() []string {
+	if x != nil {
+		return x.ChildResourceTypes
+	}
+	return nil
+}
+
+func (x *ResourceTreeNode) GetEntitlements() uint32 {
+	if x != nil {
+		return x.Entitlements
+	}
+	return 0
+}
+
+func (x *ResourceTreeNode) GetGrants() uint32 {
+	if x != nil {
+		return x.Grants
+	}
+	return 0
+}
+
+func (x *ResourceTreeNode) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *ResourceTreeNode) GetChildren() []*ResourceTreeNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type ResourceTreeOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roots         []*ResourceTreeNode    `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceTreeOutput) Reset() {
+	*x = ResourceTreeOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceTreeOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceTreeOutput) ProtoMessage() {}
+
+func (x *ResourceTreeOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceTreeOutput.ProtoReflect.Descriptor instead.
+func (*ResourceTreeOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ResourceTreeOutput) GetRoots() []*ResourceTreeNode {
+	if x != nil {
+		return x.Roots
+	}
+	return nil
+}
+
+type GroupAnalysisOutput struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Group        *v2.Resource           `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	ResourceType *v2.ResourceType       `protobuf:"bytes,2,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// Principals granted one of the group's membership entitlements directly, including nested groups.
+	DirectMembers uint32 `protobuf:"varint,3,opt,name=direct_members,json=directMembers,proto3" json:"direct_members,omitempty"`
+	DirectGroups  uint32 `protobuf:"varint,4,opt,name=direct_groups,json=directGroups,proto3" json:"direct_groups,omitempty"`
+	// Principals other than groups that are members directly or through nested groups.
+	EffectiveMembers uint32 `protobuf:"varint,5,opt,name=effective_members,json=effectiveMembers,proto3" json:"effective_members,omitempty"`
+	// The longest chain of nested groups below the group. Cycles are counted once.
+	NestingDepth  uint32 `protobuf:"varint,6,opt,name=nesting_depth,json=nestingDepth,proto3" json:"nesting_depth,omitempty"`
+	InCycle       bool   `protobuf:"varint,7,opt,name=in_cycle,json=inCycle,proto3" json:"in_cycle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupAnalysisOutput) Reset() {
+	*x = GroupAnalysisOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupAnalysisOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupAnalysisOutput) ProtoMessage() {}
+
+func (x *GroupAnalysisOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupAnalysisOutput.ProtoReflect.Descriptor instead.
+func (*GroupAnalysisOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GroupAnalysisOutput) GetGroup() *v2.Resource {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *GroupAnalysisOutput) GetResourceType() *v2.ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return nil
+}
+
+func (x *GroupAnalysisOutput) GetDirectMembers() uint32 {
+	if x != nil {
+		return x.DirectMembers
+	}
+	return 0
+}
+
+func (x *GroupAnalysisOutput) GetDirectGroups() uint32 {
+	if x != nil {
+		return x.DirectGroups
+	}
+	return 0
+}
+
+func (x *GroupAnalysisOutput) GetEffectiveMembers() uint32 {
+	if x != nil {
+		return x.EffectiveMembers
+	}
+	return 0
+}
+
+func (x *GroupAnalysisOutput) GetNestingDepth() uint32 {
+	if x != nil {
+		return x.NestingDepth
+	}
+	return 0
+}
+
+func (x *GroupAnalysisOutput) GetInCycle() bool {
+	if x != nil {
+		return x.InCycle
+	}
+	return false
+}
+
+type GroupCycleOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*v2.Resource         `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupCycleOutput) Reset() {
+	*x = GroupCycleOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupCycleOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupCycleOutput) ProtoMessage() {}
+
+func (x *GroupCycleOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupCycleOutput.ProtoReflect.Descriptor instead.
+func (*GroupCycleOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GroupCycleOutput) GetGroups() []*v2.Resource {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type GroupsAnalysisOutput struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	File            string                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Groups          uint32                 `protobuf:"varint,2,opt,name=groups,proto3" json:"groups,omitempty"`
+	MaxNestingDepth uint32                 `protobuf:"varint,3,opt,name=max_nesting_depth,json=maxNestingDepth,proto3" json:"max_nesting_depth,omitempty"`
+	// Groups with nested groups, deepest first.
+	Nested []*GroupAnalysisOutput `protobuf:"bytes,4,rep,name=nested,proto3" json:"nested,omitempty"`
+	Cycles []*GroupCycleOutput    `protobuf:"bytes,5,rep,name=cycles,proto3" json:"cycles,omitempty"`
+	// Groups whose effective membership is much larger than their direct membership.
+	FanOut        []*GroupAnalysisOutput `protobuf:"bytes,6,rep,name=fan_out,json=fanOut,proto3" json:"fan_out,omitempty"`
+	Empty         []*GroupAnalysisOutput `protobuf:"bytes,7,rep,name=empty,proto3" json:"empty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupsAnalysisOutput) Reset() {
+	*x = GroupsAnalysisOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupsAnalysisOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupsAnalysisOutput) ProtoMessage() {}
+
+func (x *GroupsAnalysisOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupsAnalysisOutput.ProtoReflect.Descriptor instead.
+func (*GroupsAnalysisOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GroupsAnalysisOutput) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *GroupsAnalysisOutput) GetGroups() uint32 {
+	if x != nil {
+		return x.Groups
+	}
+	return 0
+}
+
+func (x *GroupsAnalysisOutput) GetMaxNestingDepth() uint32 {
+	if x != nil {
+		return x.MaxNestingDepth
+	}
+	return 0
+}
+
+func (x *GroupsAnalysisOutput) GetNested() []*GroupAnalysisOutput {
+	if x != nil {
+		return x.Nested
+	}
+	return nil
+}
+
+func (x *GroupsAnalysisOutput) GetCycles() []*GroupCycleOutput {
+	if x != nil {
+		return x.Cycles
+	}
+	return nil
+}
+
+func (x *GroupsAnalysisOutput) GetFanOut() []*GroupAnalysisOutput {
+	if x != nil {
+		return x.FanOut
+	}
+	return nil
+}
+
+func (x *GroupsAnalysisOutput) GetEmpty() []*GroupAnalysisOutput {
+	if x != nil {
+		return x.Empty
+	}
+	return nil
+}
+
+type PrincipalTypeCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceType  *v2.ResourceType       `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	Direct        uint32                 `protobuf:"varint,2,opt,name=direct,proto3" json:"direct,omitempty"`
+	Effective     uint32                 `protobuf:"varint,3,opt,name=effective,proto3" json:"effective,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrincipalTypeCount) Reset() {
+	*x = PrincipalTypeCount{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrincipalTypeCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrincipalTypeCount) ProtoMessage() {}
+
+func (x *PrincipalTypeCount) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrincipalTypeCount.ProtoReflect.Descriptor instead.
+func (*PrincipalTypeCount) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *PrincipalTypeCount) GetResourceType() *v2.ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return nil
+}
+
+func (x *PrincipalTypeCount) GetDirect() uint32 {
+	if x != nil {
+		return x.Direct
+	}
+	return 0
+}
+
+func (x *PrincipalTypeCount) GetEffective() uint32 {
+	if x != nil {
+		return x.Effective
+	}
+	return 0
+}
+
+type EntitlementUsageOutput struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	File             string                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Entitlement      *v2.Entitlement        `protobuf:"bytes,2,opt,name=entitlement,proto3" json:"entitlement,omitempty"`
+	Resource         *v2.Resource           `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	ResourceType     *v2.ResourceType       `protobuf:"bytes,4,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	DirectHolders    uint32                 `protobuf:"varint,5,opt,name=direct_holders,json=directHolders,proto3" json:"direct_holders,omitempty"`
+	EffectiveHolders uint32                 `protobuf:"varint,6,opt,name=effective_holders,json=effectiveHolders,proto3" json:"effective_holders,omitempty"`
+	ByPrincipalType  []*PrincipalTypeCount  `protobuf:"bytes,7,rep,name=by_principal_type,json=byPrincipalType,proto3" json:"by_principal_type,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *EntitlementUsageOutput) Reset() {
+	*x = EntitlementUsageOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntitlementUsageOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntitlementUsageOutput) ProtoMessage() {}
+
+func (x *EntitlementUsageOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntitlementUsageOutput.ProtoReflect.Descriptor instead.
+func (*EntitlementUsageOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *EntitlementUsageOutput) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *EntitlementUsageOutput) GetEntitlement() *v2.Entitlement {
+	if x != nil {
+		return x.Entitlement
+	}
+	return nil
+}
+
+func (x *EntitlementUsageOutput) GetResource() *v2.Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *EntitlementUsageOutput) GetResourceType() *v2.ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return nil
+}
+
+func (x *EntitlementUsageOutput) GetDirectHolders() uint32 {
+	if x != nil {
+		return x.DirectHolders
+	}
+	return 0
+}
+
+func (x *EntitlementUsageOutput) GetEffectiveHolders() uint32 {
+	if x != nil {
+		return x.EffectiveHolders
+	}
+	return 0
+}
+
+func (x *EntitlementUsageOutput) GetByPrincipalType() []*PrincipalTypeCount {
+	if x != nil {
+		return x.ByPrincipalType
+	}
+	return nil
+}
+
+type EntitlementUsageReportOutput struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Files        []string               `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	Entitlements uint32                 `protobuf:"varint,2,opt,name=entitlements,proto3" json:"entitlements,omitempty"`
+	// Entitlements no principal holds, directly or inherited.
+	Unused       []*EntitlementUsageOutput `protobuf:"bytes,3,rep,name=unused,proto3" json:"unused,omitempty"`
+	SingleHolder []*EntitlementUsageOutput `protobuf:"bytes,4,rep,name=single_holder,json=singleHolder,proto3" json:"single_holder,omitempty"`
+	// The entitlements with the most effective holders, most first.
+	MostGranted []*EntitlementUsageOutput `protobuf:"bytes,5,rep,name=most_granted,json=mostGranted,proto3" json:"most_granted,omitempty"`
+	// Every entitlement, only set when requested.
+	All           []*EntitlementUsageOutput `protobuf:"bytes,6,rep,name=all,proto3" json:"all,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntitlementUsageReportOutput) Reset() {
+	*x = EntitlementUsageReportOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntitlementUsageReportOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntitlementUsageReportOutput) ProtoMessage() {}
+
+func (x *EntitlementUsageReportOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntitlementUsageReportOutput.ProtoReflect.Descriptor instead.
+func (*EntitlementUsageReportOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *EntitlementUsageReportOutput) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *EntitlementUsageReportOutput) GetEntitlements() uint32 {
+	if x != nil {
+		return x.Entitlements
+	}
+	return 0
+}
+
+func (x *EntitlementUsageReportOutput) GetUnused() []*EntitlementUsageOutput {
+	if x != nil {
+		return x.Unused
+	}
+	return nil
+}
+
+func (x *EntitlementUsageReportOutput) GetSingleHolder() []*EntitlementUsageOutput {
+	if x != nil {
+		return x.SingleHolder
+	}
+	return nil
+}
+
+func (x *EntitlementUsageReportOutput) GetMostGranted() []*EntitlementUsageOutput {
+	if x != nil {
+		return x.MostGranted
+	}
+	return nil
+}
+
+func (x *EntitlementUsageReportOutput) GetAll() []*EntitlementUsageOutput {
+	if x != nil {
+		return x.All
+	}
+	return nil
+}
+
+type PersonAccountOutput struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	File         string                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Account      *v2.Resource           `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	ResourceType *v2.ResourceType       `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	Email        string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Login        string                 `protobuf:"bytes,5,opt,name=login,proto3" json:"login,omitempty"`
+	Status       string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	// The match key, or "override", that joined the account to the person. Empty for a person with a single account.
+	MatchedBy             string            `protobuf:"bytes,7,opt,name=matched_by,json=matchedBy,proto3" json:"matched_by,omitempty"`
+	Entitlements          []*v2.Entitlement `protobuf:"bytes,8,rep,name=entitlements,proto3" json:"entitlements,omitempty"`
+	InheritedEntitlements []*v2.Entitlement `protobuf:"bytes,9,rep,name=inherited_entitlements,json=inheritedEntitlements,proto3" json:"inherited_entitlements,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *PersonAccountOutput) Reset() {
+	*x = PersonAccountOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonAccountOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonAccountOutput) ProtoMessage() {}
+
+func (x *PersonAccountOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonAccountOutput.ProtoReflect.Descriptor instead.
+func (*PersonAccountOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *PersonAccountOutput) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *PersonAccountOutput) GetAccount() *v2.Resource {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *PersonAccountOutput) GetResourceType() *v2.ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return nil
+}
+
+func (x *PersonAccountOutput) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *PersonAccountOutput) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *PersonAccountOutput) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PersonAccountOutput) GetMatchedBy() string {
+	if x != nil {
+		return x.MatchedBy
+	}
+	return ""
+}
+
+func (x *PersonAccountOutput) GetEntitlements() []*v2.Entitlement {
+	if x != nil {
+		return x.Entitlements
+	}
+	return nil
+}
+
+func (x *PersonAccountOutput) GetInheritedEntitlements() []*v2.Entitlement {
+	if x != nil {
+		return x.InheritedEntitlements
+	}
+	return nil
+}
+
+type PersonOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Files         []string               `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	MatchKeys     []string               `protobuf:"bytes,2,rep,name=match_keys,json=matchKeys,proto3" json:"match_keys,omitempty"`
+	Key           string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Emails        []string               `protobuf:"bytes,5,rep,name=emails,proto3" json:"emails,omitempty"`
+	Accounts      []*PersonAccountOutput `protobuf:"bytes,6,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersonOutput) Reset() {
+	*x = PersonOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonOutput) ProtoMessage() {}
+
+func (x *PersonOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonOutput.ProtoReflect.Descriptor instead.
+func (*PersonOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PersonOutput) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *PersonOutput) GetMatchKeys() []string {
+	if x != nil {
+		return x.MatchKeys
+	}
+	return nil
+}
+
+func (x *PersonOutput) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PersonOutput) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *PersonOutput) GetEmails() []string {
+	if x != nil {
+		return x.Emails
+	}
+	return nil
+}
+
+func (x *PersonOutput) GetAccounts() []*PersonAccountOutput {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type ReconcileMappingOutput struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SourceEntitlement *v2.Entitlement        `protobuf:"bytes,2,opt,name=source_entitlement,json=sourceEntitlement,proto3" json:"source_entitlement,omitempty"`
+	TargetEntitlement *v2.Entitlement        `protobuf:"bytes,3,opt,name=target_entitlement,json=targetEntitlement,proto3" json:"target_entitlement,omitempty"`
+	SourcePrincipals  uint32                 `protobuf:"varint,4,opt,name=source_principals,json=sourcePrincipals,proto3" json:"source_principals,omitempty"`
+	TargetPrincipals  uint32                 `protobuf:"varint,5,opt,name=target_principals,json=targetPrincipals,proto3" json:"target_principals,omitempty"`
+	Matched           uint32                 `protobuf:"varint,6,opt,name=matched,proto3" json:"matched,omitempty"`
+	// Principals of the source entitlement without a matching principal on the target entitlement.
+	Missing []*ResourceOutput `protobuf:"bytes,7,rep,name=missing,proto3" json:"missing,omitempty"`
+	// Principals of the target entitlement that no source principal matched.
+	Extra         []*ResourceOutput `protobuf:"bytes,8,rep,name=extra,proto3" json:"extra,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileMappingOutput) Reset() {
+	*x = ReconcileMappingOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileMappingOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileMappingOutput) ProtoMessage() {}
+
+func (x *ReconcileMappingOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileMappingOutput.ProtoReflect.Descriptor instead.
+func (*ReconcileMappingOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ReconcileMappingOutput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReconcileMappingOutput) GetSourceEntitlement() *v2.Entitlement {
+	if x != nil {
+		return x.SourceEntitlement
+	}
+	return nil
+}
+
+func (x *ReconcileMappingOutput) GetTargetEntitlement() *v2.Entitlement {
+	if x != nil {
+		return x.TargetEntitlement
+	}
+	return nil
+}
+
+func (x *ReconcileMappingOutput) GetSourcePrincipals() uint32 {
+	if x != nil {
+		return x.SourcePrincipals
+	}
+	return 0
+}
+
+func (x *ReconcileMappingOutput) GetTargetPrincipals() uint32 {
+	if x != nil {
+		return x.TargetPrincipals
+	}
+	return 0
+}
+
+func (x *ReconcileMappingOutput) GetMatched() uint32 {
+	if x != nil {
+		return x.Matched
+	}
+	return 0
+}
+
+func (x *ReconcileMappingOutput) GetMissing() []*ResourceOutput {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+func (x *ReconcileMappingOutput) GetExtra() []*ResourceOutput {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type ReconcileOutput struct {
+	state          protoimpl.MessageState    `protogen:"open.v1"`
+	SourceFile     string                    `protobuf:"bytes,1,opt,name=source_file,json=sourceFile,proto3" json:"source_file,omitempty"`
+	TargetFile     string                    `protobuf:"bytes,2,opt,name=target_file,json=targetFile,proto3" json:"target_file,omitempty"`
+	MatchKeys      []string                  `protobuf:"bytes,3,rep,name=match_keys,json=matchKeys,proto3" json:"match_keys,omitempty"`
+	Mappings       []*ReconcileMappingOutput `protobuf:"bytes,4,rep,name=mappings,proto3" json:"mappings,omitempty"`
+	MappingsInSync uint32                    `protobuf:"varint,5,opt,name=mappings_in_sync,json=mappingsInSync,proto3" json:"mappings_in_sync,omitempty"`
+	TotalMissing   uint32                    `protobuf:"varint,6,opt,name=total_missing,json=totalMissing,proto3" json:"total_missing,omitempty"`
+	TotalExtra     uint32                    `protobuf:"varint,7,opt,name=total_extra,json=totalExtra,proto3" json:"total_extra,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReconcileOutput) Reset() {
+	*x = ReconcileOutput{}
+	mi := &file_baton_v1_outputs_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileOutput) ProtoMessage() {}
+
+func (x *ReconcileOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_baton_v1_outputs_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileOutput.ProtoReflect.Descriptor instead.
+func (*ReconcileOutput) Descriptor() ([]byte, []int) {
+	return file_baton_v1_outputs_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ReconcileOutput) GetSourceFile() string {
+	if x != nil {
+		return x.SourceFile
+	}
+	return ""
+}
+
+func (x *ReconcileOutput) GetTargetFile() string {
+	if x != nil {
+		return x.TargetFile
+	}
+	return ""
+}
+
+func (x *ReconcileOutput) GetMatchKeys() []string {
+	if x != nil {
+		return x.MatchKeys
+	}
+	return nil
+}
+
+func (x *ReconcileOutput) GetMappings() []*ReconcileMappingOutput {
+	if x != nil {
+		return x.Mappings
+	}
+	return nil
+}
+
+func (x *ReconcileOutput) GetMappingsInSync() uint32 {
+	if x != nil {
+		return x.MappingsInSync
+	}
+	return 0
+}
+
+func (x *ReconcileOutput) GetTotalMissing() uint32 {
+	if x != nil {
+		return x.TotalMissing
+	}
+	return 0
+}
+
+func (x *ReconcileOutput) GetTotalExtra() uint32 {
+	if x != nil {
+		return x.TotalExtra
+	}
+	return 0
+}
+
+var File_baton_v1_outputs_proto protoreflect.FileDescriptor
+
+var file_baton_v1_outputs_proto_rawDesc = string([]byte{
+	0x0a, 0x16, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2f, 0x76, 0x32, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x63, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2f, 0x76, 0x32, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x63, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xaf, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x44, 0x69, 0x66, 0x66, 0x12, 0x33, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x35,
+	0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0xbb, 0x01, 0x0a, 0x0f, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x66, 0x66, 0x12, 0x36, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x36, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x08, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31,
+	0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x22, 0xa3, 0x01, 0x0a, 0x09, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x44, 0x69, 0x66,
+	0x66, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x07, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52,
+	0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0xb1, 0x01, 0x0a, 0x0d, 0x43, 0x31,
+	0x5a, 0x44, 0x69, 0x66, 0x66, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69,
+	0x66, 0x66, 0x52, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x2b, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x58, 0x0a,
+	0x12, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x31, 0x2e,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x22, 0xce, 0x01, 0x0a, 0x11, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x3e,
+	0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63,
+	0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0xaf, 0x02, 0x0a, 0x0b, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x52, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63,
+	0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x42,
+	0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x22, 0xa8, 0x02, 0x0a, 0x14,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x12, 0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x31,
+	0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x40, 0x0a, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x53, 0x0a, 0x16, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x15, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x43, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x61, 0x74, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x74,
+	0x0a, 0x12, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x80, 0x01, 0x0a, 0x15, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x3f,
+	0x0a, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x52, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x68, 0x0a, 0x0f, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x67, 0x72,
+	0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x61, 0x74,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x52, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x8b, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x37,
+	0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x09, 0x70, 0x72,
+	0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x36, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22,
+	0x97, 0x01, 0x0a, 0x14, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x22, 0xba, 0x02, 0x0a, 0x17, 0x50, 0x72,
+	0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x52, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x05, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x12, 0x2c, 0x0a, 0x04, 0x62, 0x61, 0x73,
+	0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x61, 0x74, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x64, 0x12, 0x38, 0x0a,
+	0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69,
+	0x70, 0x61, 0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xf0, 0x01, 0x0a, 0x0a, 0x53, 0x79, 0x6e, 0x63, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x79, 0x6e,
+	0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x79,
+	0x6e, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x64, 0x22, 0x64, 0x0a, 0x0e, 0x53, 0x79, 0x6e,
+	0x63, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x73,
+	0x79, 0x6e, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x61, 0x74,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x52, 0x05, 0x73, 0x79, 0x6e, 0x63, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x4b, 0x0a, 0x0b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xd7, 0x02, 0x0a,
+	0x0d, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x61, 0x74, 0x68, 0x48, 0x6f, 0x70, 0x12, 0x3e,
+	0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63,
+	0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x68, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x2b, 0x0a, 0x03, 0x76,
+	0x69, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x03, 0x76, 0x69, 0x61, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x66, 0x72, 0x6f, 0x6d, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x39, 0x0a, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x2b, 0x0a, 0x04, 0x68, 0x6f, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x50, 0x61, 0x74, 0x68, 0x48, 0x6f, 0x70, 0x52, 0x04, 0x68, 0x6f, 0x70,
+	0x73, 0x22, 0xba, 0x01, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x45, 0x78, 0x70, 0x6c,
+	0x61, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x70, 0x72, 0x69,
+	0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70,
+	0x61, 0x6c, 0x12, 0x3e, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x50, 0x61, 0x74, 0x68, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x22, 0x91,
+	0x02, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70,
+	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x44,
+	0x0a, 0x0e, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x38, 0x0a, 0x0a,
+	0x76, 0x69, 0x61, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x09, 0x76, 0x69, 0x61,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x61, 0x74, 0x68, 0x52, 0x05, 0x70, 0x61, 0x74,
+	0x68, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x18, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
+	0x3e, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x36, 0x0a, 0x07, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07,
+	0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x22, 0xd7, 0x01, 0x0a, 0x12, 0x57, 0x68, 0x6f, 0x43,
+	0x61, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x35,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63,
+	0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x52, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0x88, 0x02, 0x0a, 0x0e, 0x53, 0x6f, 0x64, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x3e, 0x0a,
+	0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x22, 0xd7, 0x01, 0x0a,
+	0x12, 0x53, 0x6f, 0x64, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76,
+	0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x76,
+	0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x6f, 0x64, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x06,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x0e, 0x53, 0x6f, 0x64, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12,
+	0x27, 0x0a, 0x0f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x45,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x0a, 0x76,
+	0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x64, 0x56, 0x69,
+	0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x0a, 0x76,
+	0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd6, 0x03, 0x0a, 0x16, 0x50, 0x72,
+	0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x44, 0x0a, 0x0e,
+	0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x66, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d,
+	0x66, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0xa1, 0x01, 0x0a, 0x16, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65,
+	0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x17, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65,
+	0x64, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x64,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x06,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62,
+	0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67,
+	0x65, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x06,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xa4, 0x04, 0x0a, 0x11, 0x44, 0x6f, 0x72, 0x6d, 0x61,
+	0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x2d, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
+	0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x39, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x61, 0x79, 0x73, 0x5f,
+	0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
+	0x64, 0x61, 0x79, 0x73, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x26, 0x0a, 0x0f,
+	0x6e, 0x65, 0x76, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6e, 0x65, 0x76, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x67,
+	0x65, 0x64, 0x49, 0x6e, 0x12, 0x40, 0x0a, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x55, 0x0a, 0x17, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c,
+	0x65, 0x67, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x16, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65,
+	0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xc2, 0x01,
+	0x0a, 0x13, 0x44, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12,
+	0x35, 0x0a, 0x07, 0x64, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x72, 0x6d,
+	0x61, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x64,
+	0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x74, 0x12, 0x4a, 0x0a, 0x13, 0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x65,
+	0x76, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x52, 0x10, 0x6e, 0x65, 0x77, 0x4e, 0x65, 0x76, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x64,
+	0x49, 0x6e, 0x22, 0xce, 0x02, 0x0a, 0x14, 0x41, 0x75, 0x74, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x75,
+	0x72, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x66, 0x61,
+	0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x6d, 0x66, 0x61, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x66,
+	0x61, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x6d, 0x66, 0x61, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x66, 0x61, 0x5f, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x66, 0x61, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x73, 0x6f, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x73, 0x6f, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x73, 0x6f, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x73, 0x6f, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x73, 0x6f, 0x5f, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x73, 0x6f, 0x55, 0x6e, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6e, 0x22, 0xb1, 0x02, 0x0a, 0x11, 0x4d, 0x66, 0x61, 0x52, 0x69, 0x73, 0x6b, 0x55,
+	0x73, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2d, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31,
+	0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0d,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x66, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x66, 0x61,
+	0x12, 0x55, 0x0a, 0x17, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x64, 0x5f, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x16, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xbb, 0x02, 0x0a, 0x17, 0x41, 0x75, 0x74, 0x68,
+	0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x37, 0x0a, 0x07, 0x62, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e,
+	0x52, 0x06, 0x62, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x48, 0x0a, 0x10, 0x62, 0x79, 0x5f, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f,
+	0x77, 0x6e, 0x52, 0x0e, 0x62, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x51, 0x0a, 0x16, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x64,
+	0x5f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x66, 0x61, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x66,
+	0x61, 0x52, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52,
+	0x14, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x6f,
+	0x75, 0x74, 0x4d, 0x66, 0x61, 0x22, 0xa0, 0x03, 0x0a, 0x13, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c,
+	0x65, 0x12, 0x33, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x40, 0x0a, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x53, 0x0a, 0x16, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64,
+	0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x15, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x78, 0x0a, 0x10, 0x4f, 0x72, 0x70, 0x68,
+	0x61, 0x6e, 0x41, 0x70, 0x70, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e,
+	0x65, 0x64, 0x22, 0xe9, 0x01, 0x0a, 0x0d, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x73, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x69, 0x64, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x70, 0x70, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x70, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x64, 0x70,
+	0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x69, 0x64,
+	0x70, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x73, 0x5f, 0x73,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62,
+	0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x41, 0x70,
+	0x70, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x73, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x37, 0x0a, 0x07, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x73, 0x22, 0xee,
+	0x02, 0x0a, 0x13, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31,
+	0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x40, 0x0a, 0x0c, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32,
+	0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x53, 0x0a, 0x16, 0x69, 0x6e,
+	0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x15, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69,
+	0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0xf4, 0x02, 0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x12, 0x2d, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
+	0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x4b,
+	0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65,
+	0x65, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x08, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x72, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x08, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x0d, 0x4c, 0x65, 0x61, 0x76, 0x65,
+	0x72, 0x73, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x70,
+	0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x70, 0x70, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x2c, 0x0a,
+	0x12, 0x69, 0x64, 0x70, 0x5f, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x69, 0x64, 0x70, 0x49, 0x6e,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x6c,
+	0x65, 0x61, 0x76, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62,
+	0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x72, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x72, 0x73, 0x22, 0xf8, 0x06,
+	0x0a, 0x0c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69,
+	0x6c, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63,
+	0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31,
+	0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x53, 0x0a, 0x16, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x14, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x38,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x3c,
+	0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x73, 0x6f, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x6f, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6e,
+	0x65, 0x76, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x6e, 0x65, 0x76, 0x65, 0x72, 0x55, 0x73, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12,
+	0x51, 0x0a, 0x15, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32,
+	0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x14, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x64, 0x0a, 0x1f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69,
+	0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31,
+	0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x1d, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x79, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62,
+	0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x22, 0xf7, 0x04,
+	0x0a, 0x14, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31,
+	0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x33, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x0c, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x32, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x53, 0x0a, 0x16, 0x69,
+	0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31,
+	0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x15, 0x69, 0x6e, 0x68, 0x65, 0x72,
+	0x69, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x55, 0x0a, 0x17, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x64, 0x5f, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x16, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x7e, 0x0a, 0x1b, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x10,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0xbb, 0x02, 0x0a, 0x13, 0x49, 0x6e, 0x73, 0x69,
+	0x67, 0x68, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x0d, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x40,
+	0x0a, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x53, 0x0a, 0x16, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x15,
+	0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xee, 0x03, 0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68,
+	0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x69,
+	0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x69,
+	0x67, 0x68, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x29, 0x0a,
+	0x10, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x69, 0x73, 0x6b, 0x5f, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x69, 0x73, 0x6b,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x6f, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x37, 0x0a,
+	0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68,
+	0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x22, 0x7b, 0x0a, 0x0e, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x33,
+	0x0a, 0x08, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x69,
+	0x67, 0x68, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x75, 0x6e, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x64, 0x22, 0xe0, 0x02, 0x0a, 0x0d, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x65, 0x65,
+	0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x65, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x65, 0x65, 0x72,
+	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x70, 0x65, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x2d, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
+	0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x8d, 0x02, 0x0a, 0x0e, 0x4f, 0x75, 0x74, 0x6c, 0x69,
+	0x65, 0x72, 0x73, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12,
+	0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x72, 0x61, 0x72, 0x65, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x04, 0x72, 0x61,
+	0x72, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61,
+	0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62,
+	0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x0f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x42, 0x61,
+	0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x17, 0x52, 0x6f, 0x6c, 0x65, 0x43,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x12, 0x3c, 0x0a, 0x0a, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0xe0, 0x01, 0x0a, 0x13, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x40, 0x0a, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x5f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x29,
+	0x0a, 0x10, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x22, 0xc3, 0x01, 0x0a, 0x10, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x53, 0x75, 0x70, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x0a, 0x63, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x0a, 0x63, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0xd1, 0x02, 0x0a, 0x10, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x35, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x31,
+	0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x68, 0x69, 0x6c,
+	0x64, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0x46, 0x0a, 0x12,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x72, 0x65, 0x65, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x72,
+	0x6f, 0x6f, 0x74, 0x73, 0x22, 0xc3, 0x02, 0x0a, 0x13, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2f, 0x0a, 0x05,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31,
+	0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x42, 0x0a,
+	0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x2b, 0x0a,
+	0x11, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x6e, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12,
+	0x19, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x69, 0x6e, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x22, 0x45, 0x0a, 0x10, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x31,
+	0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x22, 0xc6, 0x02, 0x0a, 0x14, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x41, 0x6e, 0x61, 0x6c,
+	0x79, 0x73, 0x69, 0x73, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x4e, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x70,
+	0x74, 0x68, 0x12, 0x35, 0x0a, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x52, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x79, 0x63,
+	0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x61, 0x74, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x06, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x36, 0x0a,
+	0x07, 0x66, 0x61, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x06, 0x66,
+	0x61, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x8e, 0x01, 0x0a, 0x12, 0x50,
+	0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x85, 0x03, 0x0a, 0x16,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x32, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f,
+	0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x11,
+	0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x79, 0x5f,
+	0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x0f, 0x62, 0x79, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0xd2, 0x02, 0x0a, 0x1c, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x38,
+	0x0a, 0x06, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x52, 0x06, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x12, 0x45, 0x0a, 0x0d, 0x73, 0x69, 0x6e, 0x67,
+	0x6c, 0x65, 0x5f, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x52, 0x0c, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12,
+	0x43, 0x0a, 0x0c, 0x6d, 0x6f, 0x73, 0x74, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x0b, 0x6d, 0x6f, 0x73, 0x74, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x22, 0x9c, 0x03, 0x0a, 0x13, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x69, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x0d, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x42, 0x79,
+	0x12, 0x40, 0x0a, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x53, 0x0a, 0x16, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x5f,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x15, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x0c, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x39, 0x0a, 0x08, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62,
+	0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x9e, 0x03, 0x0a, 0x16, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x12, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x32, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x11,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x4b, 0x0a, 0x12, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x63, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x32, 0x2e,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x11, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2b,
+	0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70,
+	0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x72,
+	0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52,
+	0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0xa0, 0x02, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x3c, 0x0a, 0x08, 0x6d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69,
+	0x6c, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52,
+	0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e, 0x53,
+	0x79, 0x6e, 0x63, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x45, 0x78, 0x74, 0x72, 0x61, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f,
+	0x72, 0x6f, 0x6e, 0x65, 0x2f, 0x62, 0x61, 0x74, 0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x2f, 0x62, 0x61,
+	0x74, 0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 })
 
 var (
@@ -1189,77 +6098,258 @@ func file_baton_v1_outputs_proto_rawDescGZIP() []byte {
 	return file_baton_v1_outputs_proto_rawDescData
 }
 
-var file_baton_v1_outputs_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_baton_v1_outputs_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
 var file_baton_v1_outputs_proto_goTypes = []any{
-	(*ResourceDiff)(nil),             // 0: baton.v1.ResourceDiff
-	(*EntitlementDiff)(nil),          // 1: baton.v1.EntitlementDiff
-	(*GrantDiff)(nil),                // 2: baton.v1.GrantDiff
-	(*C1ZDiffOutput)(nil),            // 3: baton.v1.C1ZDiffOutput
-	(*ResourceTypeOutput)(nil),       // 4: baton.v1.ResourceTypeOutput
-	(*ResourceOutput)(nil),           // 5: baton.v1.ResourceOutput
-	(*EntitlementOutput)(nil),        // 6: baton.v1.EntitlementOutput
-	(*GrantOutput)(nil),              // 7: baton.v1.GrantOutput
-	(*ResourceAccessOutput)(nil),     // 8: baton.v1.ResourceAccessOutput
-	(*ResourceTypeListOutput)(nil),   // 9: baton.v1.ResourceTypeListOutput
-	(*ResourceListOutput)(nil),       // 10: baton.v1.ResourceListOutput
-	(*EntitlementListOutput)(nil),    // 11: baton.v1.EntitlementListOutput
-	(*GrantListOutput)(nil),          // 12: baton.v1.GrantListOutput
-	(*ResourceAccessListOutput)(nil), // 13: baton.v1.ResourceAccessListOutput
-	(*PrincipalsCompareOutput)(nil),  // 14: baton.v1.PrincipalsCompareOutput
-	(*SyncOutput)(nil),               // 15: baton.v1.SyncOutput
-	(*SyncListOutput)(nil),           // 16: baton.v1.SyncListOutput
-	(*v2.Resource)(nil),              // 17: c1.connector.v2.Resource
-	(*v2.Entitlement)(nil),           // 18: c1.connector.v2.Entitlement
-	(*v2.Grant)(nil),                 // 19: c1.connector.v2.Grant
-	(*v2.ResourceType)(nil),          // 20: c1.connector.v2.ResourceType
-	(*timestamppb.Timestamp)(nil),    // 21: google.protobuf.Timestamp
+	(*ResourceDiff)(nil),                 // 0: baton.v1.ResourceDiff
+	(*EntitlementDiff)(nil),              // 1: baton.v1.EntitlementDiff
+	(*GrantDiff)(nil),                    // 2: baton.v1.GrantDiff
+	(*C1ZDiffOutput)(nil),                // 3: baton.v1.C1ZDiffOutput
+	(*ResourceTypeOutput)(nil),           // 4: baton.v1.ResourceTypeOutput
+	(*ResourceOutput)(nil),               // 5: baton.v1.ResourceOutput
+	(*EntitlementOutput)(nil),            // 6: baton.v1.EntitlementOutput
+	(*GrantOutput)(nil),                  // 7: baton.v1.GrantOutput
+	(*ResourceAccessOutput)(nil),         // 8: baton.v1.ResourceAccessOutput
+	(*ResourceTypeListOutput)(nil),       // 9: baton.v1.ResourceTypeListOutput
+	(*ResourceListOutput)(nil),           // 10: baton.v1.ResourceListOutput
+	(*EntitlementListOutput)(nil),        // 11: baton.v1.EntitlementListOutput
+	(*GrantListOutput)(nil),              // 12: baton.v1.GrantListOutput
+	(*ResourceAccessListOutput)(nil),     // 13: baton.v1.ResourceAccessListOutput
+	(*PrincipalMatchOutput)(nil),         // 14: baton.v1.PrincipalMatchOutput
+	(*PrincipalsCompareOutput)(nil),      // 15: baton.v1.PrincipalsCompareOutput
+	(*SyncOutput)(nil),                   // 16: baton.v1.SyncOutput
+	(*SyncListOutput)(nil),               // 17: baton.v1.SyncListOutput
+	(*CountOutput)(nil),                  // 18: baton.v1.CountOutput
+	(*AccessPathHop)(nil),                // 19: baton.v1.AccessPathHop
+	(*AccessPath)(nil),                   // 20: baton.v1.AccessPath
+	(*AccessExplainOutput)(nil),          // 21: baton.v1.AccessExplainOutput
+	(*AccessHolderOutput)(nil),           // 22: baton.v1.AccessHolderOutput
+	(*EntitlementHoldersOutput)(nil),     // 23: baton.v1.EntitlementHoldersOutput
+	(*WhoCanAccessOutput)(nil),           // 24: baton.v1.WhoCanAccessOutput
+	(*SodGrantOutput)(nil),               // 25: baton.v1.SodGrantOutput
+	(*SodViolationOutput)(nil),           // 26: baton.v1.SodViolationOutput
+	(*SodCheckOutput)(nil),               // 27: baton.v1.SodCheckOutput
+	(*PrivilegedAccessOutput)(nil),       // 28: baton.v1.PrivilegedAccessOutput
+	(*PrivilegedReportOutput)(nil),       // 29: baton.v1.PrivilegedReportOutput
+	(*DormantUserOutput)(nil),            // 30: baton.v1.DormantUserOutput
+	(*DormantReportOutput)(nil),          // 31: baton.v1.DormantReportOutput
+	(*AuthPostureBreakdown)(nil),         // 32: baton.v1.AuthPostureBreakdown
+	(*MfaRiskUserOutput)(nil),            // 33: baton.v1.MfaRiskUserOutput
+	(*AuthPostureReportOutput)(nil),      // 34: baton.v1.AuthPostureReportOutput
+	(*OrphanAccountOutput)(nil),          // 35: baton.v1.OrphanAccountOutput
+	(*OrphanAppSummary)(nil),             // 36: baton.v1.OrphanAppSummary
+	(*OrphansOutput)(nil),                // 37: baton.v1.OrphansOutput
+	(*LeaverAccountOutput)(nil),          // 38: baton.v1.LeaverAccountOutput
+	(*LeaverOutput)(nil),                 // 39: baton.v1.LeaverOutput
+	(*LeaversOutput)(nil),                // 40: baton.v1.LeaversOutput
+	(*SecretOutput)(nil),                 // 41: baton.v1.SecretOutput
+	(*SecretsReportOutput)(nil),          // 42: baton.v1.SecretsReportOutput
+	(*ServiceAccountOutput)(nil),         // 43: baton.v1.ServiceAccountOutput
+	(*ServiceAccountsReportOutput)(nil),  // 44: baton.v1.ServiceAccountsReportOutput
+	(*InsightTargetOutput)(nil),          // 45: baton.v1.InsightTargetOutput
+	(*InsightOutput)(nil),                // 46: baton.v1.InsightOutput
+	(*InsightsOutput)(nil),               // 47: baton.v1.InsightsOutput
+	(*OutlierOutput)(nil),                // 48: baton.v1.OutlierOutput
+	(*OutliersOutput)(nil),               // 49: baton.v1.OutliersOutput
+	(*RoleCandidateUserOutput)(nil),      // 50: baton.v1.RoleCandidateUserOutput
+	(*RoleCandidateOutput)(nil),          // 51: baton.v1.RoleCandidateOutput
+	(*RoleMiningOutput)(nil),             // 52: baton.v1.RoleMiningOutput
+	(*ResourceTreeNode)(nil),             // 53: baton.v1.ResourceTreeNode
+	(*ResourceTreeOutput)(nil),           // 54: baton.v1.ResourceTreeOutput
+	(*GroupAnalysisOutput)(nil),          // 55: baton.v1.GroupAnalysisOutput
+	(*GroupCycleOutput)(nil),             // 56: baton.v1.GroupCycleOutput
+	(*GroupsAnalysisOutput)(nil),         // 57: baton.v1.GroupsAnalysisOutput
+	(*PrincipalTypeCount)(nil),           // 58: baton.v1.PrincipalTypeCount
+	(*EntitlementUsageOutput)(nil),       // 59: baton.v1.EntitlementUsageOutput
+	(*EntitlementUsageReportOutput)(nil), // 60: baton.v1.EntitlementUsageReportOutput
+	(*PersonAccountOutput)(nil),          // 61: baton.v1.PersonAccountOutput
+	(*PersonOutput)(nil),                 // 62: baton.v1.PersonOutput
+	(*ReconcileMappingOutput)(nil),       // 63: baton.v1.ReconcileMappingOutput
+	(*ReconcileOutput)(nil),              // 64: baton.v1.ReconcileOutput
+	(*v2.Resource)(nil),                  // 65: c1.connector.v2.Resource
+	(*v2.Entitlement)(nil),               // 66: c1.connector.v2.Entitlement
+	(*v2.Grant)(nil),                     // 67: c1.connector.v2.Grant
+	(*v2.ResourceType)(nil),              // 68: c1.connector.v2.ResourceType
+	(*timestamppb.Timestamp)(nil),        // 69: google.protobuf.Timestamp
 }
 var file_baton_v1_outputs_proto_depIdxs = []int32{
-	17, // 0: baton.v1.ResourceDiff.created:type_name -> c1.connector.v2.Resource
-	17, // 1: baton.v1.ResourceDiff.deleted:type_name -> c1.connector.v2.Resource
-	17, // 2: baton.v1.ResourceDiff.modified:type_name -> c1.connector.v2.Resource
-	18, // 3: baton.v1.EntitlementDiff.created:type_name -> c1.connector.v2.Entitlement
-	18, // 4: baton.v1.EntitlementDiff.deleted:type_name -> c1.connector.v2.Entitlement
-	18, // 5: baton.v1.EntitlementDiff.modified:type_name -> c1.connector.v2.Entitlement
-	19, // 6: baton.v1.GrantDiff.created:type_name -> c1.connector.v2.Grant
-	19, // 7: baton.v1.GrantDiff.deleted:type_name -> c1.connector.v2.Grant
-	19, // 8: baton.v1.GrantDiff.modified:type_name -> c1.connector.v2.Grant
-	0,  // 9: baton.v1.C1ZDiffOutput.resources:type_name -> baton.v1.ResourceDiff
-	1,  // 10: baton.v1.C1ZDiffOutput.entitlements:type_name -> baton.v1.EntitlementDiff
-	2,  // 11: baton.v1.C1ZDiffOutput.grants:type_name -> baton.v1.GrantDiff
-	20, // 12: baton.v1.ResourceTypeOutput.resource_type:type_name -> c1.connector.v2.ResourceType
-	17, // 13: baton.v1.ResourceOutput.resource:type_name -> c1.connector.v2.Resource
-	20, // 14: baton.v1.ResourceOutput.resource_type:type_name -> c1.connector.v2.ResourceType
-	17, // 15: baton.v1.ResourceOutput.parent:type_name -> c1.connector.v2.Resource
-	18, // 16: baton.v1.EntitlementOutput.entitlement:type_name -> c1.connector.v2.Entitlement
-	17, // 17: baton.v1.EntitlementOutput.resource:type_name -> c1.connector.v2.Resource
-	20, // 18: baton.v1.EntitlementOutput.resource_type:type_name -> c1.connector.v2.ResourceType
-	19, // 19: baton.v1.GrantOutput.grant:type_name -> c1.connector.v2.Grant
-	18, // 20: baton.v1.GrantOutput.entitlement:type_name -> c1.connector.v2.Entitlement
-	17, // 21: baton.v1.GrantOutput.resource:type_name -> c1.connector.v2.Resource
-	20, // 22: baton.v1.GrantOutput.resource_type:type_name -> c1.connector.v2.ResourceType
-	17, // 23: baton.v1.GrantOutput.principal:type_name -> c1.connector.v2.Resource
-	20, // 24: baton.v1.ResourceAccessOutput.resource_type:type_name -> c1.connector.v2.ResourceType
-	17, // 25: baton.v1.ResourceAccessOutput.resource:type_name -> c1.connector.v2.Resource
-	18, // 26: baton.v1.ResourceAccessOutput.entitlements:type_name -> c1.connector.v2.Entitlement
-	4,  // 27: baton.v1.ResourceTypeListOutput.resource_types:type_name -> baton.v1.ResourceTypeOutput
-	5,  // 28: baton.v1.ResourceListOutput.resources:type_name -> baton.v1.ResourceOutput
-	6,  // 29: baton.v1.EntitlementListOutput.entitlements:type_name -> baton.v1.EntitlementOutput
-	7,  // 30: baton.v1.GrantListOutput.grants:type_name -> baton.v1.GrantOutput
-	17, // 31: baton.v1.ResourceAccessListOutput.principal:type_name -> c1.connector.v2.Resource
-	8,  // 32: baton.v1.ResourceAccessListOutput.access:type_name -> baton.v1.ResourceAccessOutput
-	5,  // 33: baton.v1.PrincipalsCompareOutput.missing:type_name -> baton.v1.ResourceOutput
-	5,  // 34: baton.v1.PrincipalsCompareOutput.extra:type_name -> baton.v1.ResourceOutput
-	5,  // 35: baton.v1.PrincipalsCompareOutput.base:type_name -> baton.v1.ResourceOutput
-	5,  // 36: baton.v1.PrincipalsCompareOutput.compared:type_name -> baton.v1.ResourceOutput
-	21, // 37: baton.v1.SyncOutput.started_at:type_name -> google.protobuf.Timestamp
-	21, // 38: baton.v1.SyncOutput.ended_at:type_name -> google.protobuf.Timestamp
-	15, // 39: baton.v1.SyncListOutput.syncs:type_name -> baton.v1.SyncOutput
-	40, // [40:40] is the sub-list for method output_type
-	40, // [40:40] is the sub-list for method input_type
-	40, // [40:40] is the sub-list for extension type_name
-	40, // [40:40] is the sub-list for extension extendee
-	0,  // [0:40] is the sub-list for field type_name
+	65,  // 0: baton.v1.ResourceDiff.created:type_name -> c1.connector.v2.Resource
+	65,  // 1: baton.v1.ResourceDiff.deleted:type_name -> c1.connector.v2.Resource
+	65,  // 2: baton.v1.ResourceDiff.modified:type_name -> c1.connector.v2.Resource
+	66,  // 3: baton.v1.EntitlementDiff.created:type_name -> c1.connector.v2.Entitlement
+	66,  // 4: baton.v1.EntitlementDiff.deleted:type_name -> c1.connector.v2.Entitlement
+	66,  // 5: baton.v1.EntitlementDiff.modified:type_name -> c1.connector.v2.Entitlement
+	67,  // 6: baton.v1.GrantDiff.created:type_name -> c1.connector.v2.Grant
+	67,  // 7: baton.v1.GrantDiff.deleted:type_name -> c1.connector.v2.Grant
+	67,  // 8: baton.v1.GrantDiff.modified:type_name -> c1.connector.v2.Grant
+	0,   // 9: baton.v1.C1ZDiffOutput.resources:type_name -> baton.v1.ResourceDiff
+	1,   // 10: baton.v1.C1ZDiffOutput.entitlements:type_name -> baton.v1.EntitlementDiff
+	2,   // 11: baton.v1.C1ZDiffOutput.grants:type_name -> baton.v1.GrantDiff
+	68,  // 12: baton.v1.ResourceTypeOutput.resource_type:type_name -> c1.connector.v2.ResourceType
+	65,  // 13: baton.v1.ResourceOutput.resource:type_name -> c1.connector.v2.Resource
+	68,  // 14: baton.v1.ResourceOutput.resource_type:type_name -> c1.connector.v2.ResourceType
+	65,  // 15: baton.v1.ResourceOutput.parent:type_name -> c1.connector.v2.Resource
+	66,  // 16: baton.v1.EntitlementOutput.entitlement:type_name -> c1.connector.v2.Entitlement
+	65,  // 17: baton.v1.EntitlementOutput.resource:type_name -> c1.connector.v2.Resource
+	68,  // 18: baton.v1.EntitlementOutput.resource_type:type_name -> c1.connector.v2.ResourceType
+	67,  // 19: baton.v1.GrantOutput.grant:type_name -> c1.connector.v2.Grant
+	66,  // 20: baton.v1.GrantOutput.entitlement:type_name -> c1.connector.v2.Entitlement
+	65,  // 21: baton.v1.GrantOutput.resource:type_name -> c1.connector.v2.Resource
+	68,  // 22: baton.v1.GrantOutput.resource_type:type_name -> c1.connector.v2.ResourceType
+	65,  // 23: baton.v1.GrantOutput.principal:type_name -> c1.connector.v2.Resource
+	68,  // 24: baton.v1.ResourceAccessOutput.resource_type:type_name -> c1.connector.v2.ResourceType
+	65,  // 25: baton.v1.ResourceAccessOutput.resource:type_name -> c1.connector.v2.Resource
+	66,  // 26: baton.v1.ResourceAccessOutput.entitlements:type_name -> c1.connector.v2.Entitlement
+	66,  // 27: baton.v1.ResourceAccessOutput.inherited_entitlements:type_name -> c1.connector.v2.Entitlement
+	4,   // 28: baton.v1.ResourceTypeListOutput.resource_types:type_name -> baton.v1.ResourceTypeOutput
+	5,   // 29: baton.v1.ResourceListOutput.resources:type_name -> baton.v1.ResourceOutput
+	6,   // 30: baton.v1.EntitlementListOutput.entitlements:type_name -> baton.v1.EntitlementOutput
+	7,   // 31: baton.v1.GrantListOutput.grants:type_name -> baton.v1.GrantOutput
+	65,  // 32: baton.v1.ResourceAccessListOutput.principal:type_name -> c1.connector.v2.Resource
+	8,   // 33: baton.v1.ResourceAccessListOutput.access:type_name -> baton.v1.ResourceAccessOutput
+	5,   // 34: baton.v1.PrincipalMatchOutput.base:type_name -> baton.v1.ResourceOutput
+	5,   // 35: baton.v1.PrincipalMatchOutput.compared:type_name -> baton.v1.ResourceOutput
+	5,   // 36: baton.v1.PrincipalsCompareOutput.missing:type_name -> baton.v1.ResourceOutput
+	5,   // 37: baton.v1.PrincipalsCompareOutput.extra:type_name -> baton.v1.ResourceOutput
+	5,   // 38: baton.v1.PrincipalsCompareOutput.base:type_name -> baton.v1.ResourceOutput
+	5,   // 39: baton.v1.PrincipalsCompareOutput.compared:type_name -> baton.v1.ResourceOutput
+	14,  // 40: baton.v1.PrincipalsCompareOutput.matched:type_name -> baton.v1.PrincipalMatchOutput
+	69,  // 41: baton.v1.SyncOutput.started_at:type_name -> google.protobuf.Timestamp
+	69,  // 42: baton.v1.SyncOutput.ended_at:type_name -> google.protobuf.Timestamp
+	16,  // 43: baton.v1.SyncListOutput.syncs:type_name -> baton.v1.SyncOutput
+	66,  // 44: baton.v1.AccessPathHop.entitlement:type_name -> c1.connector.v2.Entitlement
+	65,  // 45: baton.v1.AccessPathHop.resource:type_name -> c1.connector.v2.Resource
+	68,  // 46: baton.v1.AccessPathHop.resource_type:type_name -> c1.connector.v2.ResourceType
+	65,  // 47: baton.v1.AccessPathHop.via:type_name -> c1.connector.v2.Resource
+	19,  // 48: baton.v1.AccessPath.hops:type_name -> baton.v1.AccessPathHop
+	65,  // 49: baton.v1.AccessExplainOutput.principal:type_name -> c1.connector.v2.Resource
+	66,  // 50: baton.v1.AccessExplainOutput.entitlement:type_name -> c1.connector.v2.Entitlement
+	20,  // 51: baton.v1.AccessExplainOutput.paths:type_name -> baton.v1.AccessPath
+	65,  // 52: baton.v1.AccessHolderOutput.principal:type_name -> c1.connector.v2.Resource
+	68,  // 53: baton.v1.AccessHolderOutput.principal_type:type_name -> c1.connector.v2.ResourceType
+	65,  // 54: baton.v1.AccessHolderOutput.via_groups:type_name -> c1.connector.v2.Resource
+	20,  // 55: baton.v1.AccessHolderOutput.paths:type_name -> baton.v1.AccessPath
+	66,  // 56: baton.v1.EntitlementHoldersOutput.entitlement:type_name -> c1.connector.v2.Entitlement
+	22,  // 57: baton.v1.EntitlementHoldersOutput.holders:type_name -> baton.v1.AccessHolderOutput
+	65,  // 58: baton.v1.WhoCanAccessOutput.resource:type_name -> c1.connector.v2.Resource
+	68,  // 59: baton.v1.WhoCanAccessOutput.resource_type:type_name -> c1.connector.v2.ResourceType
+	23,  // 60: baton.v1.WhoCanAccessOutput.entitlements:type_name -> baton.v1.EntitlementHoldersOutput
+	65,  // 61: baton.v1.SodGrantOutput.principal:type_name -> c1.connector.v2.Resource
+	66,  // 62: baton.v1.SodGrantOutput.entitlement:type_name -> c1.connector.v2.Entitlement
+	65,  // 63: baton.v1.SodGrantOutput.resource:type_name -> c1.connector.v2.Resource
+	25,  // 64: baton.v1.SodViolationOutput.grants:type_name -> baton.v1.SodGrantOutput
+	26,  // 65: baton.v1.SodCheckOutput.violations:type_name -> baton.v1.SodViolationOutput
+	66,  // 66: baton.v1.PrivilegedAccessOutput.entitlement:type_name -> c1.connector.v2.Entitlement
+	65,  // 67: baton.v1.PrivilegedAccessOutput.resource:type_name -> c1.connector.v2.Resource
+	68,  // 68: baton.v1.PrivilegedAccessOutput.resource_type:type_name -> c1.connector.v2.ResourceType
+	65,  // 69: baton.v1.PrivilegedAccessOutput.principal:type_name -> c1.connector.v2.Resource
+	68,  // 70: baton.v1.PrivilegedAccessOutput.principal_type:type_name -> c1.connector.v2.ResourceType
+	28,  // 71: baton.v1.PrivilegedReportOutput.access:type_name -> baton.v1.PrivilegedAccessOutput
+	65,  // 72: baton.v1.DormantUserOutput.user:type_name -> c1.connector.v2.Resource
+	68,  // 73: baton.v1.DormantUserOutput.resource_type:type_name -> c1.connector.v2.ResourceType
+	69,  // 74: baton.v1.DormantUserOutput.last_login:type_name -> google.protobuf.Timestamp
+	69,  // 75: baton.v1.DormantUserOutput.created_at:type_name -> google.protobuf.Timestamp
+	66,  // 76: baton.v1.DormantUserOutput.entitlements:type_name -> c1.connector.v2.Entitlement
+	66,  // 77: baton.v1.DormantUserOutput.privileged_entitlements:type_name -> c1.connector.v2.Entitlement
+	30,  // 78: baton.v1.DormantReportOutput.dormant:type_name -> baton.v1.DormantUserOutput
+	30,  // 79: baton.v1.DormantReportOutput.new_never_logged_in:type_name -> baton.v1.DormantUserOutput
+	68,  // 80: baton.v1.AuthPostureBreakdown.resource_type:type_name -> c1.connector.v2.ResourceType
+	65,  // 81: baton.v1.MfaRiskUserOutput.user:type_name -> c1.connector.v2.Resource
+	68,  // 82: baton.v1.MfaRiskUserOutput.resource_type:type_name -> c1.connector.v2.ResourceType
+	66,  // 83: baton.v1.MfaRiskUserOutput.privileged_entitlements:type_name -> c1.connector.v2.Entitlement
+	32,  // 84: baton.v1.AuthPostureReportOutput.total:type_name -> baton.v1.AuthPostureBreakdown
+	32,  // 85: baton.v1.AuthPostureReportOutput.by_file:type_name -> baton.v1.AuthPostureBreakdown
+	32,  // 86: baton.v1.AuthPostureReportOutput.by_resource_type:type_name -> baton.v1.AuthPostureBreakdown
+	33,  // 87: baton.v1.AuthPostureReportOutput.privileged_without_mfa:type_name -> baton.v1.MfaRiskUserOutput
+	65,  // 88: baton.v1.OrphanAccountOutput.account:type_name -> c1.connector.v2.Resource
+	68,  // 89: baton.v1.OrphanAccountOutput.resource_type:type_name -> c1.connector.v2.ResourceType
+	66,  // 90: baton.v1.OrphanAccountOutput.entitlements:type_name -> c1.connector.v2.Entitlement
+	66,  // 91: baton.v1.OrphanAccountOutput.inherited_entitlements:type_name -> c1.connector.v2.Entitlement
+	36,  // 92: baton.v1.OrphansOutput.apps_summary:type_name -> baton.v1.OrphanAppSummary
+	35,  // 93: baton.v1.OrphansOutput.orphans:type_name -> baton.v1.OrphanAccountOutput
+	65,  // 94: baton.v1.LeaverAccountOutput.account:type_name -> c1.connector.v2.Resource
+	68,  // 95: baton.v1.LeaverAccountOutput.resource_type:type_name -> c1.connector.v2.ResourceType
+	66,  // 96: baton.v1.LeaverAccountOutput.entitlements:type_name -> c1.connector.v2.Entitlement
+	66,  // 97: baton.v1.LeaverAccountOutput.inherited_entitlements:type_name -> c1.connector.v2.Entitlement
+	65,  // 98: baton.v1.LeaverOutput.user:type_name -> c1.connector.v2.Resource
+	68,  // 99: baton.v1.LeaverOutput.resource_type:type_name -> c1.connector.v2.ResourceType
+	69,  // 100: baton.v1.LeaverOutput.disabled_at:type_name -> google.protobuf.Timestamp
+	69,  // 101: baton.v1.LeaverOutput.last_seen_enabled_at:type_name -> google.protobuf.Timestamp
+	38,  // 102: baton.v1.LeaverOutput.accounts:type_name -> baton.v1.LeaverAccountOutput
+	39,  // 103: baton.v1.LeaversOutput.leavers:type_name -> baton.v1.LeaverOutput
+	65,  // 104: baton.v1.SecretOutput.secret:type_name -> c1.connector.v2.Resource
+	68,  // 105: baton.v1.SecretOutput.resource_type:type_name -> c1.connector.v2.ResourceType
+	65,  // 106: baton.v1.SecretOutput.identity:type_name -> c1.connector.v2.Resource
+	68,  // 107: baton.v1.SecretOutput.identity_resource_type:type_name -> c1.connector.v2.ResourceType
+	65,  // 108: baton.v1.SecretOutput.created_by:type_name -> c1.connector.v2.Resource
+	69,  // 109: baton.v1.SecretOutput.created_at:type_name -> google.protobuf.Timestamp
+	69,  // 110: baton.v1.SecretOutput.expires_at:type_name -> google.protobuf.Timestamp
+	69,  // 111: baton.v1.SecretOutput.last_used_at:type_name -> google.protobuf.Timestamp
+	66,  // 112: baton.v1.SecretOutput.identity_entitlements:type_name -> c1.connector.v2.Entitlement
+	66,  // 113: baton.v1.SecretOutput.identity_inherited_entitlements:type_name -> c1.connector.v2.Entitlement
+	41,  // 114: baton.v1.SecretsReportOutput.secrets:type_name -> baton.v1.SecretOutput
+	65,  // 115: baton.v1.ServiceAccountOutput.account:type_name -> c1.connector.v2.Resource
+	68,  // 116: baton.v1.ServiceAccountOutput.resource_type:type_name -> c1.connector.v2.ResourceType
+	69,  // 117: baton.v1.ServiceAccountOutput.created_at:type_name -> google.protobuf.Timestamp
+	69,  // 118: baton.v1.ServiceAccountOutput.last_login:type_name -> google.protobuf.Timestamp
+	65,  // 119: baton.v1.ServiceAccountOutput.secrets:type_name -> c1.connector.v2.Resource
+	66,  // 120: baton.v1.ServiceAccountOutput.entitlements:type_name -> c1.connector.v2.Entitlement
+	66,  // 121: baton.v1.ServiceAccountOutput.inherited_entitlements:type_name -> c1.connector.v2.Entitlement
+	66,  // 122: baton.v1.ServiceAccountOutput.privileged_entitlements:type_name -> c1.connector.v2.Entitlement
+	43,  // 123: baton.v1.ServiceAccountsReportOutput.service_accounts:type_name -> baton.v1.ServiceAccountOutput
+	65,  // 124: baton.v1.InsightTargetOutput.resource:type_name -> c1.connector.v2.Resource
+	68,  // 125: baton.v1.InsightTargetOutput.resource_type:type_name -> c1.connector.v2.ResourceType
+	66,  // 126: baton.v1.InsightTargetOutput.entitlements:type_name -> c1.connector.v2.Entitlement
+	66,  // 127: baton.v1.InsightTargetOutput.inherited_entitlements:type_name -> c1.connector.v2.Entitlement
+	65,  // 128: baton.v1.InsightOutput.insight:type_name -> c1.connector.v2.Resource
+	68,  // 129: baton.v1.InsightOutput.resource_type:type_name -> c1.connector.v2.ResourceType
+	69,  // 130: baton.v1.InsightOutput.observed_at:type_name -> google.protobuf.Timestamp
+	45,  // 131: baton.v1.InsightOutput.targets:type_name -> baton.v1.InsightTargetOutput
+	46,  // 132: baton.v1.InsightsOutput.insights:type_name -> baton.v1.InsightOutput
+	65,  // 133: baton.v1.OutlierOutput.user:type_name -> c1.connector.v2.Resource
+	68,  // 134: baton.v1.OutlierOutput.resource_type:type_name -> c1.connector.v2.ResourceType
+	66,  // 135: baton.v1.OutlierOutput.entitlement:type_name -> c1.connector.v2.Entitlement
+	48,  // 136: baton.v1.OutliersOutput.rare:type_name -> baton.v1.OutlierOutput
+	48,  // 137: baton.v1.OutliersOutput.missing_baseline:type_name -> baton.v1.OutlierOutput
+	65,  // 138: baton.v1.RoleCandidateUserOutput.user:type_name -> c1.connector.v2.Resource
+	66,  // 139: baton.v1.RoleCandidateUserOutput.exceptions:type_name -> c1.connector.v2.Entitlement
+	66,  // 140: baton.v1.RoleCandidateOutput.entitlements:type_name -> c1.connector.v2.Entitlement
+	50,  // 141: baton.v1.RoleCandidateOutput.users:type_name -> baton.v1.RoleCandidateUserOutput
+	51,  // 142: baton.v1.RoleMiningOutput.candidates:type_name -> baton.v1.RoleCandidateOutput
+	65,  // 143: baton.v1.ResourceTreeNode.resource:type_name -> c1.connector.v2.Resource
+	68,  // 144: baton.v1.ResourceTreeNode.resource_type:type_name -> c1.connector.v2.ResourceType
+	53,  // 145: baton.v1.ResourceTreeNode.children:type_name -> baton.v1.ResourceTreeNode
+	53,  // 146: baton.v1.ResourceTreeOutput.roots:type_name -> baton.v1.ResourceTreeNode
+	65,  // 147: baton.v1.GroupAnalysisOutput.group:type_name -> c1.connector.v2.Resource
+	68,  // 148: baton.v1.GroupAnalysisOutput.resource_type:type_name -> c1.connector.v2.ResourceType
+	65,  // 149: baton.v1.GroupCycleOutput.groups:type_name -> c1.connector.v2.Resource
+	55,  // 150: baton.v1.GroupsAnalysisOutput.nested:type_name -> baton.v1.GroupAnalysisOutput
+	56,  // 151: baton.v1.GroupsAnalysisOutput.cycles:type_name -> baton.v1.GroupCycleOutput
+	55,  // 152: baton.v1.GroupsAnalysisOutput.fan_out:type_name -> baton.v1.GroupAnalysisOutput
+	55,  // 153: baton.v1.GroupsAnalysisOutput.empty:type_name -> baton.v1.GroupAnalysisOutput
+	68,  // 154: baton.v1.PrincipalTypeCount.resource_type:type_name -> c1.connector.v2.ResourceType
+	66,  // 155: baton.v1.EntitlementUsageOutput.entitlement:type_name -> c1.connector.v2.Entitlement
+	65,  // 156: baton.v1.EntitlementUsageOutput.resource:type_name -> c1.connector.v2.Resource
+	68,  // 157: baton.v1.EntitlementUsageOutput.resource_type:type_name -> c1.connector.v2.ResourceType
+	58,  // 158: baton.v1.EntitlementUsageOutput.by_principal_type:type_name -> baton.v1.PrincipalTypeCount
+	59,  // 159: baton.v1.EntitlementUsageReportOutput.unused:type_name -> baton.v1.EntitlementUsageOutput
+	59,  // 160: baton.v1.EntitlementUsageReportOutput.single_holder:type_name -> baton.v1.EntitlementUsageOutput
+	59,  // 161: baton.v1.EntitlementUsageReportOutput.most_granted:type_name -> baton.v1.EntitlementUsageOutput
+	59,  // 162: baton.v1.EntitlementUsageReportOutput.all:type_name -> baton.v1.EntitlementUsageOutput
+	65,  // 163: baton.v1.PersonAccountOutput.account:type_name -> c1.connector.v2.Resource
+	68,  // 164: baton.v1.PersonAccountOutput.resource_type:type_name -> c1.connector.v2.ResourceType
+	66,  // 165: baton.v1.PersonAccountOutput.entitlements:type_name -> c1.connector.v2.Entitlement
+	66,  // 166: baton.v1.PersonAccountOutput.inherited_entitlements:type_name -> c1.connector.v2.Entitlement
+	61,  // 167: baton.v1.PersonOutput.accounts:type_name -> baton.v1.PersonAccountOutput
+	66,  // 168: baton.v1.ReconcileMappingOutput.source_entitlement:type_name -> c1.connector.v2.Entitlement
+	66,  // 169: baton.v1.ReconcileMappingOutput.target_entitlement:type_name -> c1.connector.v2.Entitlement
+	5,   // 170: baton.v1.ReconcileMappingOutput.missing:type_name -> baton.v1.ResourceOutput
+	5,   // 171: baton.v1.ReconcileMappingOutput.extra:type_name -> baton.v1.ResourceOutput
+	63,  // 172: baton.v1.ReconcileOutput.mappings:type_name -> baton.v1.ReconcileMappingOutput
+	173, // [173:173] is the sub-list for method output_type
+	173, // [173:173] is the sub-list for method input_type
+	173, // [173:173] is the sub-list for extension type_name
+	173, // [173:173] is the sub-list for extension extendee
+	0,   // [0:173] is the sub-list for field type_name
 }
 
 func init() { file_baton_v1_outputs_proto_init() }
@@ -1273,7 +6363,7 @@ func file_baton_v1_outputs_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_baton_v1_outputs_proto_rawDesc), len(file_baton_v1_outputs_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   65,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
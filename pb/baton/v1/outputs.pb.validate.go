@@ -1691,6 +1691,40 @@ func (m *ResourceAccessOutput) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetInheritedEntitlements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ResourceAccessOutputValidationError{
+						field:  fmt.Sprintf("InheritedEntitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ResourceAccessOutputValidationError{
+						field:  fmt.Sprintf("InheritedEntitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ResourceAccessOutputValidationError{
+					field:  fmt.Sprintf("InheritedEntitlements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ResourceAccessOutputMultiError(errors)
 	}
@@ -1827,6 +1861,8 @@ func (m *ResourceTypeListOutput) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return ResourceTypeListOutputMultiError(errors)
 	}
@@ -1963,6 +1999,8 @@ func (m *ResourceListOutput) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return ResourceListOutputMultiError(errors)
 	}
@@ -2099,6 +2137,8 @@ func (m *EntitlementListOutput) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return EntitlementListOutputMultiError(errors)
 	}
@@ -2235,6 +2275,8 @@ func (m *GrantListOutput) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return GrantListOutputMultiError(errors)
 	}
@@ -2478,6 +2520,168 @@ var _ interface {
 	ErrorName() string
 } = ResourceAccessListOutputValidationError{}
 
+// Validate checks the field values on PrincipalMatchOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PrincipalMatchOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PrincipalMatchOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PrincipalMatchOutputMultiError, or nil if none found.
+func (m *PrincipalMatchOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PrincipalMatchOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBase()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PrincipalMatchOutputValidationError{
+					field:  "Base",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PrincipalMatchOutputValidationError{
+					field:  "Base",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBase()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PrincipalMatchOutputValidationError{
+				field:  "Base",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCompared()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PrincipalMatchOutputValidationError{
+					field:  "Compared",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PrincipalMatchOutputValidationError{
+					field:  "Compared",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCompared()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PrincipalMatchOutputValidationError{
+				field:  "Compared",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MatchKey
+
+	if len(errors) > 0 {
+		return PrincipalMatchOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// PrincipalMatchOutputMultiError is an error wrapping multiple validation
+// errors returned by PrincipalMatchOutput.ValidateAll() if the designated
+// constraints aren't met.
+type PrincipalMatchOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PrincipalMatchOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PrincipalMatchOutputMultiError) AllErrors() []error { return m }
+
+// PrincipalMatchOutputValidationError is the validation error returned by
+// PrincipalMatchOutput.Validate if the designated constraints aren't met.
+type PrincipalMatchOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PrincipalMatchOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PrincipalMatchOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PrincipalMatchOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PrincipalMatchOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PrincipalMatchOutputValidationError) ErrorName() string {
+	return "PrincipalMatchOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PrincipalMatchOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPrincipalMatchOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PrincipalMatchOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PrincipalMatchOutputValidationError{}
+
 // Validate checks the field values on PrincipalsCompareOutput with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -2636,6 +2840,40 @@ func (m *PrincipalsCompareOutput) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetMatched() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PrincipalsCompareOutputValidationError{
+						field:  fmt.Sprintf("Matched[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PrincipalsCompareOutputValidationError{
+						field:  fmt.Sprintf("Matched[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PrincipalsCompareOutputValidationError{
+					field:  fmt.Sprintf("Matched[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return PrincipalsCompareOutputMultiError(errors)
 	}
@@ -2937,6 +3175,8 @@ func (m *SyncListOutput) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return SyncListOutputMultiError(errors)
 	}
@@ -3014,3 +3254,9052 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = SyncListOutputValidationError{}
+
+// Validate checks the field values on CountOutput with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CountOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CountOutput with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CountOutputMultiError, or
+// nil if none found.
+func (m *CountOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CountOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return CountOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// CountOutputMultiError is an error wrapping multiple validation errors
+// returned by CountOutput.ValidateAll() if the designated constraints aren't met.
+type CountOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CountOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CountOutputMultiError) AllErrors() []error { return m }
+
+// CountOutputValidationError is the validation error returned by
+// CountOutput.Validate if the designated constraints aren't met.
+type CountOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CountOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CountOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CountOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CountOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CountOutputValidationError) ErrorName() string { return "CountOutputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CountOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCountOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CountOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CountOutputValidationError{}
+
+// Validate checks the field values on AccessPathHop with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AccessPathHop) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessPathHop with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AccessPathHopMultiError, or
+// nil if none found.
+func (m *AccessPathHop) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessPathHop) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEntitlement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessPathHopValidationError{
+					field:  "Entitlement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessPathHopValidationError{
+					field:  "Entitlement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntitlement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessPathHopValidationError{
+				field:  "Entitlement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessPathHopValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessPathHopValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessPathHopValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResourceType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessPathHopValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessPathHopValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResourceType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessPathHopValidationError{
+				field:  "ResourceType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Direct
+
+	// no validation rules for Shallow
+
+	if all {
+		switch v := interface{}(m.GetVia()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessPathHopValidationError{
+					field:  "Via",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessPathHopValidationError{
+					field:  "Via",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVia()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessPathHopValidationError{
+				field:  "Via",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FromGrantSources
+
+	if len(errors) > 0 {
+		return AccessPathHopMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessPathHopMultiError is an error wrapping multiple validation errors
+// returned by AccessPathHop.ValidateAll() if the designated constraints
+// aren't met.
+type AccessPathHopMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessPathHopMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessPathHopMultiError) AllErrors() []error { return m }
+
+// AccessPathHopValidationError is the validation error returned by
+// AccessPathHop.Validate if the designated constraints aren't met.
+type AccessPathHopValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessPathHopValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessPathHopValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessPathHopValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessPathHopValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessPathHopValidationError) ErrorName() string { return "AccessPathHopValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AccessPathHopValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessPathHop.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessPathHopValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessPathHopValidationError{}
+
+// Validate checks the field values on AccessPath with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AccessPath) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessPath with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AccessPathMultiError, or
+// nil if none found.
+func (m *AccessPath) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessPath) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHops() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AccessPathValidationError{
+						field:  fmt.Sprintf("Hops[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AccessPathValidationError{
+						field:  fmt.Sprintf("Hops[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AccessPathValidationError{
+					field:  fmt.Sprintf("Hops[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AccessPathMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessPathMultiError is an error wrapping multiple validation errors
+// returned by AccessPath.ValidateAll() if the designated constraints aren't met.
+type AccessPathMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessPathMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessPathMultiError) AllErrors() []error { return m }
+
+// AccessPathValidationError is the validation error returned by
+// AccessPath.Validate if the designated constraints aren't met.
+type AccessPathValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessPathValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessPathValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessPathValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessPathValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessPathValidationError) ErrorName() string { return "AccessPathValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AccessPathValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessPath.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessPathValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessPathValidationError{}
+
+// Validate checks the field values on AccessExplainOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AccessExplainOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessExplainOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccessExplainOutputMultiError, or nil if none found.
+func (m *AccessExplainOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessExplainOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPrincipal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessExplainOutputValidationError{
+					field:  "Principal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessExplainOutputValidationError{
+					field:  "Principal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrincipal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessExplainOutputValidationError{
+				field:  "Principal",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEntitlement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessExplainOutputValidationError{
+					field:  "Entitlement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessExplainOutputValidationError{
+					field:  "Entitlement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntitlement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessExplainOutputValidationError{
+				field:  "Entitlement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetPaths() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AccessExplainOutputValidationError{
+						field:  fmt.Sprintf("Paths[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AccessExplainOutputValidationError{
+						field:  fmt.Sprintf("Paths[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AccessExplainOutputValidationError{
+					field:  fmt.Sprintf("Paths[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AccessExplainOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessExplainOutputMultiError is an error wrapping multiple validation
+// errors returned by AccessExplainOutput.ValidateAll() if the designated
+// constraints aren't met.
+type AccessExplainOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessExplainOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessExplainOutputMultiError) AllErrors() []error { return m }
+
+// AccessExplainOutputValidationError is the validation error returned by
+// AccessExplainOutput.Validate if the designated constraints aren't met.
+type AccessExplainOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessExplainOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessExplainOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessExplainOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessExplainOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessExplainOutputValidationError) ErrorName() string {
+	return "AccessExplainOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AccessExplainOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessExplainOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessExplainOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessExplainOutputValidationError{}
+
+// Validate checks the field values on AccessHolderOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AccessHolderOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessHolderOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccessHolderOutputMultiError, or nil if none found.
+func (m *AccessHolderOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessHolderOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPrincipal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessHolderOutputValidationError{
+					field:  "Principal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessHolderOutputValidationError{
+					field:  "Principal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrincipal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessHolderOutputValidationError{
+				field:  "Principal",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPrincipalType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessHolderOutputValidationError{
+					field:  "PrincipalType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessHolderOutputValidationError{
+					field:  "PrincipalType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrincipalType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessHolderOutputValidationError{
+				field:  "PrincipalType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Direct
+
+	for idx, item := range m.GetViaGroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AccessHolderOutputValidationError{
+						field:  fmt.Sprintf("ViaGroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AccessHolderOutputValidationError{
+						field:  fmt.Sprintf("ViaGroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AccessHolderOutputValidationError{
+					field:  fmt.Sprintf("ViaGroups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPaths() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AccessHolderOutputValidationError{
+						field:  fmt.Sprintf("Paths[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AccessHolderOutputValidationError{
+						field:  fmt.Sprintf("Paths[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AccessHolderOutputValidationError{
+					field:  fmt.Sprintf("Paths[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AccessHolderOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessHolderOutputMultiError is an error wrapping multiple validation errors
+// returned by AccessHolderOutput.ValidateAll() if the designated constraints
+// aren't met.
+type AccessHolderOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessHolderOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessHolderOutputMultiError) AllErrors() []error { return m }
+
+// AccessHolderOutputValidationError is the validation error returned by
+// AccessHolderOutput.Validate if the designated constraints aren't met.
+type AccessHolderOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessHolderOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessHolderOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessHolderOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessHolderOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessHolderOutputValidationError) ErrorName() string {
+	return "AccessHolderOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AccessHolderOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessHolderOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessHolderOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessHolderOutputValidationError{}
+
+// Validate checks the field values on EntitlementHoldersOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EntitlementHoldersOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EntitlementHoldersOutput with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EntitlementHoldersOutputMultiError, or nil if none found.
+func (m *EntitlementHoldersOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EntitlementHoldersOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEntitlement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EntitlementHoldersOutputValidationError{
+					field:  "Entitlement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EntitlementHoldersOutputValidationError{
+					field:  "Entitlement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntitlement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EntitlementHoldersOutputValidationError{
+				field:  "Entitlement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetHolders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EntitlementHoldersOutputValidationError{
+						field:  fmt.Sprintf("Holders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EntitlementHoldersOutputValidationError{
+						field:  fmt.Sprintf("Holders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EntitlementHoldersOutputValidationError{
+					field:  fmt.Sprintf("Holders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return EntitlementHoldersOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// EntitlementHoldersOutputMultiError is an error wrapping multiple validation
+// errors returned by EntitlementHoldersOutput.ValidateAll() if the designated
+// constraints aren't met.
+type EntitlementHoldersOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EntitlementHoldersOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EntitlementHoldersOutputMultiError) AllErrors() []error { return m }
+
+// EntitlementHoldersOutputValidationError is the validation error returned by
+// EntitlementHoldersOutput.Validate if the designated constraints aren't met.
+type EntitlementHoldersOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EntitlementHoldersOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EntitlementHoldersOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EntitlementHoldersOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EntitlementHoldersOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EntitlementHoldersOutputValidationError) ErrorName() string {
+	return "EntitlementHoldersOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EntitlementHoldersOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEntitlementHoldersOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EntitlementHoldersOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EntitlementHoldersOutputValidationError{}
+
+// Validate checks the field values on WhoCanAccessOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WhoCanAccessOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WhoCanAccessOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WhoCanAccessOutputMultiError, or nil if none found.
+func (m *WhoCanAccessOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WhoCanAccessOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WhoCanAccessOutputValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WhoCanAccessOutputValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WhoCanAccessOutputValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResourceType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WhoCanAccessOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WhoCanAccessOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResourceType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WhoCanAccessOutputValidationError{
+				field:  "ResourceType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetEntitlements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WhoCanAccessOutputValidationError{
+						field:  fmt.Sprintf("Entitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WhoCanAccessOutputValidationError{
+						field:  fmt.Sprintf("Entitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WhoCanAccessOutputValidationError{
+					field:  fmt.Sprintf("Entitlements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WhoCanAccessOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// WhoCanAccessOutputMultiError is an error wrapping multiple validation errors
+// returned by WhoCanAccessOutput.ValidateAll() if the designated constraints
+// aren't met.
+type WhoCanAccessOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WhoCanAccessOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WhoCanAccessOutputMultiError) AllErrors() []error { return m }
+
+// WhoCanAccessOutputValidationError is the validation error returned by
+// WhoCanAccessOutput.Validate if the designated constraints aren't met.
+type WhoCanAccessOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WhoCanAccessOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WhoCanAccessOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WhoCanAccessOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WhoCanAccessOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WhoCanAccessOutputValidationError) ErrorName() string {
+	return "WhoCanAccessOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WhoCanAccessOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWhoCanAccessOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WhoCanAccessOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WhoCanAccessOutputValidationError{}
+
+// Validate checks the field values on SodGrantOutput with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SodGrantOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SodGrantOutput with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SodGrantOutputMultiError,
+// or nil if none found.
+func (m *SodGrantOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SodGrantOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for File
+
+	// no validation rules for Conflict
+
+	if all {
+		switch v := interface{}(m.GetPrincipal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SodGrantOutputValidationError{
+					field:  "Principal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SodGrantOutputValidationError{
+					field:  "Principal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrincipal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SodGrantOutputValidationError{
+				field:  "Principal",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEntitlement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SodGrantOutputValidationError{
+					field:  "Entitlement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SodGrantOutputValidationError{
+					field:  "Entitlement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntitlement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SodGrantOutputValidationError{
+				field:  "Entitlement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SodGrantOutputValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SodGrantOutputValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SodGrantOutputValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Direct
+
+	if len(errors) > 0 {
+		return SodGrantOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// SodGrantOutputMultiError is an error wrapping multiple validation errors
+// returned by SodGrantOutput.ValidateAll() if the designated constraints
+// aren't met.
+type SodGrantOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SodGrantOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SodGrantOutputMultiError) AllErrors() []error { return m }
+
+// SodGrantOutputValidationError is the validation error returned by
+// SodGrantOutput.Validate if the designated constraints aren't met.
+type SodGrantOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SodGrantOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SodGrantOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SodGrantOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SodGrantOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SodGrantOutputValidationError) ErrorName() string { return "SodGrantOutputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SodGrantOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSodGrantOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SodGrantOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SodGrantOutputValidationError{}
+
+// Validate checks the field values on SodViolationOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SodViolationOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SodViolationOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SodViolationOutputMultiError, or nil if none found.
+func (m *SodViolationOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SodViolationOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Rule
+
+	// no validation rules for Description
+
+	// no validation rules for Severity
+
+	// no validation rules for Identity
+
+	// no validation rules for DisplayName
+
+	for idx, item := range m.GetGrants() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SodViolationOutputValidationError{
+						field:  fmt.Sprintf("Grants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SodViolationOutputValidationError{
+						field:  fmt.Sprintf("Grants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SodViolationOutputValidationError{
+					field:  fmt.Sprintf("Grants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SodViolationOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// SodViolationOutputMultiError is an error wrapping multiple validation errors
+// returned by SodViolationOutput.ValidateAll() if the designated constraints
+// aren't met.
+type SodViolationOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SodViolationOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SodViolationOutputMultiError) AllErrors() []error { return m }
+
+// SodViolationOutputValidationError is the validation error returned by
+// SodViolationOutput.Validate if the designated constraints aren't met.
+type SodViolationOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SodViolationOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SodViolationOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SodViolationOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SodViolationOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SodViolationOutputValidationError) ErrorName() string {
+	return "SodViolationOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SodViolationOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSodViolationOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SodViolationOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SodViolationOutputValidationError{}
+
+// Validate checks the field values on SodCheckOutput with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SodCheckOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SodCheckOutput with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SodCheckOutputMultiError,
+// or nil if none found.
+func (m *SodCheckOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SodCheckOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RulesEvaluated
+
+	// no validation rules for IdentitiesEvaluated
+
+	for idx, item := range m.GetViolations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SodCheckOutputValidationError{
+						field:  fmt.Sprintf("Violations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SodCheckOutputValidationError{
+						field:  fmt.Sprintf("Violations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SodCheckOutputValidationError{
+					field:  fmt.Sprintf("Violations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SodCheckOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// SodCheckOutputMultiError is an error wrapping multiple validation errors
+// returned by SodCheckOutput.ValidateAll() if the designated constraints
+// aren't met.
+type SodCheckOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SodCheckOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SodCheckOutputMultiError) AllErrors() []error { return m }
+
+// SodCheckOutputValidationError is the validation error returned by
+// SodCheckOutput.Validate if the designated constraints aren't met.
+type SodCheckOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SodCheckOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SodCheckOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SodCheckOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SodCheckOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SodCheckOutputValidationError) ErrorName() string { return "SodCheckOutputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SodCheckOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSodCheckOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SodCheckOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SodCheckOutputValidationError{}
+
+// Validate checks the field values on PrivilegedAccessOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PrivilegedAccessOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PrivilegedAccessOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PrivilegedAccessOutputMultiError, or nil if none found.
+func (m *PrivilegedAccessOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PrivilegedAccessOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for File
+
+	if all {
+		switch v := interface{}(m.GetEntitlement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PrivilegedAccessOutputValidationError{
+					field:  "Entitlement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PrivilegedAccessOutputValidationError{
+					field:  "Entitlement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntitlement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PrivilegedAccessOutputValidationError{
+				field:  "Entitlement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PrivilegedAccessOutputValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PrivilegedAccessOutputValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PrivilegedAccessOutputValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResourceType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PrivilegedAccessOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PrivilegedAccessOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResourceType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PrivilegedAccessOutputValidationError{
+				field:  "ResourceType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPrincipal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PrivilegedAccessOutputValidationError{
+					field:  "Principal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PrivilegedAccessOutputValidationError{
+					field:  "Principal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrincipal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PrivilegedAccessOutputValidationError{
+				field:  "Principal",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPrincipalType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PrivilegedAccessOutputValidationError{
+					field:  "PrincipalType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PrivilegedAccessOutputValidationError{
+					field:  "PrincipalType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrincipalType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PrivilegedAccessOutputValidationError{
+				field:  "PrincipalType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Email
+
+	// no validation rules for Status
+
+	// no validation rules for Mfa
+
+	// no validation rules for Direct
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return PrivilegedAccessOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// PrivilegedAccessOutputMultiError is an error wrapping multiple validation
+// errors returned by PrivilegedAccessOutput.ValidateAll() if the designated
+// constraints aren't met.
+type PrivilegedAccessOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PrivilegedAccessOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PrivilegedAccessOutputMultiError) AllErrors() []error { return m }
+
+// PrivilegedAccessOutputValidationError is the validation error returned by
+// PrivilegedAccessOutput.Validate if the designated constraints aren't met.
+type PrivilegedAccessOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PrivilegedAccessOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PrivilegedAccessOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PrivilegedAccessOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PrivilegedAccessOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PrivilegedAccessOutputValidationError) ErrorName() string {
+	return "PrivilegedAccessOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PrivilegedAccessOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPrivilegedAccessOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PrivilegedAccessOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PrivilegedAccessOutputValidationError{}
+
+// Validate checks the field values on PrivilegedReportOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PrivilegedReportOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PrivilegedReportOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PrivilegedReportOutputMultiError, or nil if none found.
+func (m *PrivilegedReportOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PrivilegedReportOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PrivilegedEntitlements
+
+	for idx, item := range m.GetAccess() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PrivilegedReportOutputValidationError{
+						field:  fmt.Sprintf("Access[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PrivilegedReportOutputValidationError{
+						field:  fmt.Sprintf("Access[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PrivilegedReportOutputValidationError{
+					field:  fmt.Sprintf("Access[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PrivilegedReportOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// PrivilegedReportOutputMultiError is an error wrapping multiple validation
+// errors returned by PrivilegedReportOutput.ValidateAll() if the designated
+// constraints aren't met.
+type PrivilegedReportOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PrivilegedReportOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PrivilegedReportOutputMultiError) AllErrors() []error { return m }
+
+// PrivilegedReportOutputValidationError is the validation error returned by
+// PrivilegedReportOutput.Validate if the designated constraints aren't met.
+type PrivilegedReportOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PrivilegedReportOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PrivilegedReportOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PrivilegedReportOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PrivilegedReportOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PrivilegedReportOutputValidationError) ErrorName() string {
+	return "PrivilegedReportOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PrivilegedReportOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPrivilegedReportOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PrivilegedReportOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PrivilegedReportOutputValidationError{}
+
+// Validate checks the field values on DormantUserOutput with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DormantUserOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DormantUserOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DormantUserOutputMultiError, or nil if none found.
+func (m *DormantUserOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DormantUserOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for File
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DormantUserOutputValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DormantUserOutputValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DormantUserOutputValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResourceType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DormantUserOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DormantUserOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResourceType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DormantUserOutputValidationError{
+				field:  "ResourceType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Email
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetLastLogin()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DormantUserOutputValidationError{
+					field:  "LastLogin",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DormantUserOutputValidationError{
+					field:  "LastLogin",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastLogin()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DormantUserOutputValidationError{
+				field:  "LastLogin",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DormantUserOutputValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DormantUserOutputValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DormantUserOutputValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DaysInactive
+
+	// no validation rules for NeverLoggedIn
+
+	for idx, item := range m.GetEntitlements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DormantUserOutputValidationError{
+						field:  fmt.Sprintf("Entitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DormantUserOutputValidationError{
+						field:  fmt.Sprintf("Entitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DormantUserOutputValidationError{
+					field:  fmt.Sprintf("Entitlements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPrivilegedEntitlements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DormantUserOutputValidationError{
+						field:  fmt.Sprintf("PrivilegedEntitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DormantUserOutputValidationError{
+						field:  fmt.Sprintf("PrivilegedEntitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DormantUserOutputValidationError{
+					field:  fmt.Sprintf("PrivilegedEntitlements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DormantUserOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// DormantUserOutputMultiError is an error wrapping multiple validation errors
+// returned by DormantUserOutput.ValidateAll() if the designated constraints
+// aren't met.
+type DormantUserOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DormantUserOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DormantUserOutputMultiError) AllErrors() []error { return m }
+
+// DormantUserOutputValidationError is the validation error returned by
+// DormantUserOutput.Validate if the designated constraints aren't met.
+type DormantUserOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DormantUserOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DormantUserOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DormantUserOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DormantUserOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DormantUserOutputValidationError) ErrorName() string {
+	return "DormantUserOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DormantUserOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDormantUserOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DormantUserOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DormantUserOutputValidationError{}
+
+// Validate checks the field values on DormantReportOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DormantReportOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DormantReportOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DormantReportOutputMultiError, or nil if none found.
+func (m *DormantReportOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DormantReportOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Days
+
+	for idx, item := range m.GetDormant() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DormantReportOutputValidationError{
+						field:  fmt.Sprintf("Dormant[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DormantReportOutputValidationError{
+						field:  fmt.Sprintf("Dormant[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DormantReportOutputValidationError{
+					field:  fmt.Sprintf("Dormant[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetNewNeverLoggedIn() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DormantReportOutputValidationError{
+						field:  fmt.Sprintf("NewNeverLoggedIn[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DormantReportOutputValidationError{
+						field:  fmt.Sprintf("NewNeverLoggedIn[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DormantReportOutputValidationError{
+					field:  fmt.Sprintf("NewNeverLoggedIn[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DormantReportOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// DormantReportOutputMultiError is an error wrapping multiple validation
+// errors returned by DormantReportOutput.ValidateAll() if the designated
+// constraints aren't met.
+type DormantReportOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DormantReportOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DormantReportOutputMultiError) AllErrors() []error { return m }
+
+// DormantReportOutputValidationError is the validation error returned by
+// DormantReportOutput.Validate if the designated constraints aren't met.
+type DormantReportOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DormantReportOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DormantReportOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DormantReportOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DormantReportOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DormantReportOutputValidationError) ErrorName() string {
+	return "DormantReportOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DormantReportOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDormantReportOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DormantReportOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DormantReportOutputValidationError{}
+
+// Validate checks the field values on AuthPostureBreakdown with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthPostureBreakdown) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthPostureBreakdown with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthPostureBreakdownMultiError, or nil if none found.
+func (m *AuthPostureBreakdown) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthPostureBreakdown) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for File
+
+	if all {
+		switch v := interface{}(m.GetResourceType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthPostureBreakdownValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthPostureBreakdownValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResourceType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthPostureBreakdownValidationError{
+				field:  "ResourceType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Users
+
+	// no validation rules for MfaEnabled
+
+	// no validation rules for MfaDisabled
+
+	// no validation rules for MfaUnknown
+
+	// no validation rules for SsoEnabled
+
+	// no validation rules for SsoDisabled
+
+	// no validation rules for SsoUnknown
+
+	if len(errors) > 0 {
+		return AuthPostureBreakdownMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthPostureBreakdownMultiError is an error wrapping multiple validation
+// errors returned by AuthPostureBreakdown.ValidateAll() if the designated
+// constraints aren't met.
+type AuthPostureBreakdownMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthPostureBreakdownMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthPostureBreakdownMultiError) AllErrors() []error { return m }
+
+// AuthPostureBreakdownValidationError is the validation error returned by
+// AuthPostureBreakdown.Validate if the designated constraints aren't met.
+type AuthPostureBreakdownValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthPostureBreakdownValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthPostureBreakdownValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthPostureBreakdownValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthPostureBreakdownValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthPostureBreakdownValidationError) ErrorName() string {
+	return "AuthPostureBreakdownValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthPostureBreakdownValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthPostureBreakdown.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthPostureBreakdownValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthPostureBreakdownValidationError{}
+
+// Validate checks the field values on MfaRiskUserOutput with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MfaRiskUserOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MfaRiskUserOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MfaRiskUserOutputMultiError, or nil if none found.
+func (m *MfaRiskUserOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MfaRiskUserOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for File
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MfaRiskUserOutputValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MfaRiskUserOutputValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MfaRiskUserOutputValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResourceType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MfaRiskUserOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MfaRiskUserOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResourceType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MfaRiskUserOutputValidationError{
+				field:  "ResourceType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Email
+
+	// no validation rules for Status
+
+	// no validation rules for Mfa
+
+	for idx, item := range m.GetPrivilegedEntitlements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MfaRiskUserOutputValidationError{
+						field:  fmt.Sprintf("PrivilegedEntitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MfaRiskUserOutputValidationError{
+						field:  fmt.Sprintf("PrivilegedEntitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MfaRiskUserOutputValidationError{
+					field:  fmt.Sprintf("PrivilegedEntitlements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MfaRiskUserOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// MfaRiskUserOutputMultiError is an error wrapping multiple validation errors
+// returned by MfaRiskUserOutput.ValidateAll() if the designated constraints
+// aren't met.
+type MfaRiskUserOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MfaRiskUserOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MfaRiskUserOutputMultiError) AllErrors() []error { return m }
+
+// MfaRiskUserOutputValidationError is the validation error returned by
+// MfaRiskUserOutput.Validate if the designated constraints aren't met.
+type MfaRiskUserOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MfaRiskUserOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MfaRiskUserOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MfaRiskUserOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MfaRiskUserOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MfaRiskUserOutputValidationError) ErrorName() string {
+	return "MfaRiskUserOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MfaRiskUserOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMfaRiskUserOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MfaRiskUserOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MfaRiskUserOutputValidationError{}
+
+// Validate checks the field values on AuthPostureReportOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthPostureReportOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthPostureReportOutput with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthPostureReportOutputMultiError, or nil if none found.
+func (m *AuthPostureReportOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthPostureReportOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTotal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthPostureReportOutputValidationError{
+					field:  "Total",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthPostureReportOutputValidationError{
+					field:  "Total",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTotal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthPostureReportOutputValidationError{
+				field:  "Total",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetByFile() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AuthPostureReportOutputValidationError{
+						field:  fmt.Sprintf("ByFile[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AuthPostureReportOutputValidationError{
+						field:  fmt.Sprintf("ByFile[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AuthPostureReportOutputValidationError{
+					field:  fmt.Sprintf("ByFile[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetByResourceType() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AuthPostureReportOutputValidationError{
+						field:  fmt.Sprintf("ByResourceType[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AuthPostureReportOutputValidationError{
+						field:  fmt.Sprintf("ByResourceType[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AuthPostureReportOutputValidationError{
+					field:  fmt.Sprintf("ByResourceType[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPrivilegedWithoutMfa() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AuthPostureReportOutputValidationError{
+						field:  fmt.Sprintf("PrivilegedWithoutMfa[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AuthPostureReportOutputValidationError{
+						field:  fmt.Sprintf("PrivilegedWithoutMfa[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AuthPostureReportOutputValidationError{
+					field:  fmt.Sprintf("PrivilegedWithoutMfa[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AuthPostureReportOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthPostureReportOutputMultiError is an error wrapping multiple validation
+// errors returned by AuthPostureReportOutput.ValidateAll() if the designated
+// constraints aren't met.
+type AuthPostureReportOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthPostureReportOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthPostureReportOutputMultiError) AllErrors() []error { return m }
+
+// AuthPostureReportOutputValidationError is the validation error returned by
+// AuthPostureReportOutput.Validate if the designated constraints aren't met.
+type AuthPostureReportOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthPostureReportOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthPostureReportOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthPostureReportOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthPostureReportOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthPostureReportOutputValidationError) ErrorName() string {
+	return "AuthPostureReportOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthPostureReportOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthPostureReportOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthPostureReportOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthPostureReportOutputValidationError{}
+
+// Validate checks the field values on OrphanAccountOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrphanAccountOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrphanAccountOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrphanAccountOutputMultiError, or nil if none found.
+func (m *OrphanAccountOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrphanAccountOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for File
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrphanAccountOutputValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrphanAccountOutputValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrphanAccountOutputValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResourceType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrphanAccountOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrphanAccountOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResourceType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrphanAccountOutputValidationError{
+				field:  "ResourceType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Email
+
+	// no validation rules for Login
+
+	// no validation rules for Status
+
+	// no validation rules for AccountType
+
+	for idx, item := range m.GetEntitlements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrphanAccountOutputValidationError{
+						field:  fmt.Sprintf("Entitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrphanAccountOutputValidationError{
+						field:  fmt.Sprintf("Entitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrphanAccountOutputValidationError{
+					field:  fmt.Sprintf("Entitlements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetInheritedEntitlements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrphanAccountOutputValidationError{
+						field:  fmt.Sprintf("InheritedEntitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrphanAccountOutputValidationError{
+						field:  fmt.Sprintf("InheritedEntitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrphanAccountOutputValidationError{
+					field:  fmt.Sprintf("InheritedEntitlements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OrphanAccountOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrphanAccountOutputMultiError is an error wrapping multiple validation
+// errors returned by OrphanAccountOutput.ValidateAll() if the designated
+// constraints aren't met.
+type OrphanAccountOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrphanAccountOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrphanAccountOutputMultiError) AllErrors() []error { return m }
+
+// OrphanAccountOutputValidationError is the validation error returned by
+// OrphanAccountOutput.Validate if the designated constraints aren't met.
+type OrphanAccountOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrphanAccountOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrphanAccountOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrphanAccountOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrphanAccountOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrphanAccountOutputValidationError) ErrorName() string {
+	return "OrphanAccountOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrphanAccountOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrphanAccountOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrphanAccountOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrphanAccountOutputValidationError{}
+
+// Validate checks the field values on OrphanAppSummary with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OrphanAppSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrphanAppSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrphanAppSummaryMultiError, or nil if none found.
+func (m *OrphanAppSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrphanAppSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for File
+
+	// no validation rules for Accounts
+
+	// no validation rules for Matched
+
+	// no validation rules for Orphaned
+
+	if len(errors) > 0 {
+		return OrphanAppSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrphanAppSummaryMultiError is an error wrapping multiple validation errors
+// returned by OrphanAppSummary.ValidateAll() if the designated constraints
+// aren't met.
+type OrphanAppSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrphanAppSummaryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrphanAppSummaryMultiError) AllErrors() []error { return m }
+
+// OrphanAppSummaryValidationError is the validation error returned by
+// OrphanAppSummary.Validate if the designated constraints aren't met.
+type OrphanAppSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrphanAppSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrphanAppSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrphanAppSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrphanAppSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrphanAppSummaryValidationError) ErrorName() string { return "OrphanAppSummaryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrphanAppSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrphanAppSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrphanAppSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrphanAppSummaryValidationError{}
+
+// Validate checks the field values on OrphansOutput with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrphansOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrphansOutput with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrphansOutputMultiError, or
+// nil if none found.
+func (m *OrphansOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrphansOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Idp
+
+	// no validation rules for IdpUsers
+
+	for idx, item := range m.GetAppsSummary() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrphansOutputValidationError{
+						field:  fmt.Sprintf("AppsSummary[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrphansOutputValidationError{
+						field:  fmt.Sprintf("AppsSummary[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrphansOutputValidationError{
+					field:  fmt.Sprintf("AppsSummary[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetOrphans() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrphansOutputValidationError{
+						field:  fmt.Sprintf("Orphans[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrphansOutputValidationError{
+						field:  fmt.Sprintf("Orphans[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrphansOutputValidationError{
+					field:  fmt.Sprintf("Orphans[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OrphansOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrphansOutputMultiError is an error wrapping multiple validation errors
+// returned by OrphansOutput.ValidateAll() if the designated constraints
+// aren't met.
+type OrphansOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrphansOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrphansOutputMultiError) AllErrors() []error { return m }
+
+// OrphansOutputValidationError is the validation error returned by
+// OrphansOutput.Validate if the designated constraints aren't met.
+type OrphansOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrphansOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrphansOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrphansOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrphansOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrphansOutputValidationError) ErrorName() string { return "OrphansOutputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrphansOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrphansOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrphansOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrphansOutputValidationError{}
+
+// Validate checks the field values on LeaverAccountOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LeaverAccountOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LeaverAccountOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LeaverAccountOutputMultiError, or nil if none found.
+func (m *LeaverAccountOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LeaverAccountOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for File
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LeaverAccountOutputValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LeaverAccountOutputValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LeaverAccountOutputValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResourceType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LeaverAccountOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LeaverAccountOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResourceType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LeaverAccountOutputValidationError{
+				field:  "ResourceType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Status
+
+	// no validation rules for MatchKey
+
+	for idx, item := range m.GetEntitlements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeaverAccountOutputValidationError{
+						field:  fmt.Sprintf("Entitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeaverAccountOutputValidationError{
+						field:  fmt.Sprintf("Entitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeaverAccountOutputValidationError{
+					field:  fmt.Sprintf("Entitlements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetInheritedEntitlements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeaverAccountOutputValidationError{
+						field:  fmt.Sprintf("InheritedEntitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeaverAccountOutputValidationError{
+						field:  fmt.Sprintf("InheritedEntitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeaverAccountOutputValidationError{
+					field:  fmt.Sprintf("InheritedEntitlements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return LeaverAccountOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeaverAccountOutputMultiError is an error wrapping multiple validation
+// errors returned by LeaverAccountOutput.ValidateAll() if the designated
+// constraints aren't met.
+type LeaverAccountOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeaverAccountOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeaverAccountOutputMultiError) AllErrors() []error { return m }
+
+// LeaverAccountOutputValidationError is the validation error returned by
+// LeaverAccountOutput.Validate if the designated constraints aren't met.
+type LeaverAccountOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeaverAccountOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeaverAccountOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeaverAccountOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeaverAccountOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeaverAccountOutputValidationError) ErrorName() string {
+	return "LeaverAccountOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LeaverAccountOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeaverAccountOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeaverAccountOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeaverAccountOutputValidationError{}
+
+// Validate checks the field values on LeaverOutput with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LeaverOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LeaverOutput with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LeaverOutputMultiError, or
+// nil if none found.
+func (m *LeaverOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LeaverOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LeaverOutputValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LeaverOutputValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LeaverOutputValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResourceType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LeaverOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LeaverOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResourceType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LeaverOutputValidationError{
+				field:  "ResourceType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Email
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetDisabledAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LeaverOutputValidationError{
+					field:  "DisabledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LeaverOutputValidationError{
+					field:  "DisabledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDisabledAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LeaverOutputValidationError{
+				field:  "DisabledAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastSeenEnabledAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LeaverOutputValidationError{
+					field:  "LastSeenEnabledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LeaverOutputValidationError{
+					field:  "LastSeenEnabledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastSeenEnabledAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LeaverOutputValidationError{
+				field:  "LastSeenEnabledAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetAccounts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeaverOutputValidationError{
+						field:  fmt.Sprintf("Accounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeaverOutputValidationError{
+						field:  fmt.Sprintf("Accounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeaverOutputValidationError{
+					field:  fmt.Sprintf("Accounts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return LeaverOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeaverOutputMultiError is an error wrapping multiple validation errors
+// returned by LeaverOutput.ValidateAll() if the designated constraints aren't met.
+type LeaverOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeaverOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeaverOutputMultiError) AllErrors() []error { return m }
+
+// LeaverOutputValidationError is the validation error returned by
+// LeaverOutput.Validate if the designated constraints aren't met.
+type LeaverOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeaverOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeaverOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeaverOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeaverOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeaverOutputValidationError) ErrorName() string { return "LeaverOutputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LeaverOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeaverOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeaverOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeaverOutputValidationError{}
+
+// Validate checks the field values on LeaversOutput with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LeaversOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LeaversOutput with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LeaversOutputMultiError, or
+// nil if none found.
+func (m *LeaversOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LeaversOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Idp
+
+	// no validation rules for IdpInactiveUsers
+
+	for idx, item := range m.GetLeavers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeaversOutputValidationError{
+						field:  fmt.Sprintf("Leavers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeaversOutputValidationError{
+						field:  fmt.Sprintf("Leavers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeaversOutputValidationError{
+					field:  fmt.Sprintf("Leavers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return LeaversOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeaversOutputMultiError is an error wrapping multiple validation errors
+// returned by LeaversOutput.ValidateAll() if the designated constraints
+// aren't met.
+type LeaversOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeaversOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeaversOutputMultiError) AllErrors() []error { return m }
+
+// LeaversOutputValidationError is the validation error returned by
+// LeaversOutput.Validate if the designated constraints aren't met.
+type LeaversOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeaversOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeaversOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeaversOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeaversOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeaversOutputValidationError) ErrorName() string { return "LeaversOutputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LeaversOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeaversOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeaversOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeaversOutputValidationError{}
+
+// Validate checks the field values on SecretOutput with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SecretOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SecretOutput with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SecretOutputMultiError, or
+// nil if none found.
+func (m *SecretOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SecretOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for File
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SecretOutputValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SecretOutputValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SecretOutputValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResourceType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SecretOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SecretOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResourceType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SecretOutputValidationError{
+				field:  "ResourceType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetIdentity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SecretOutputValidationError{
+					field:  "Identity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SecretOutputValidationError{
+					field:  "Identity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIdentity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SecretOutputValidationError{
+				field:  "Identity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetIdentityResourceType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SecretOutputValidationError{
+					field:  "IdentityResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SecretOutputValidationError{
+					field:  "IdentityResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIdentityResourceType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SecretOutputValidationError{
+				field:  "IdentityResourceType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IdentityStatus
+
+	if all {
+		switch v := interface{}(m.GetCreatedBy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SecretOutputValidationError{
+					field:  "CreatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SecretOutputValidationError{
+					field:  "CreatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedBy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SecretOutputValidationError{
+				field:  "CreatedBy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SecretOutputValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SecretOutputValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SecretOutputValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SecretOutputValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SecretOutputValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SecretOutputValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastUsedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SecretOutputValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SecretOutputValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastUsedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SecretOutputValidationError{
+				field:  "LastUsedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Expired
+
+	// no validation rules for ExpiringSoon
+
+	// no validation rules for NeverUsed
+
+	// no validation rules for OwnerDeleted
+
+	for idx, item := range m.GetIdentityEntitlements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SecretOutputValidationError{
+						field:  fmt.Sprintf("IdentityEntitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SecretOutputValidationError{
+						field:  fmt.Sprintf("IdentityEntitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SecretOutputValidationError{
+					field:  fmt.Sprintf("IdentityEntitlements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetIdentityInheritedEntitlements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SecretOutputValidationError{
+						field:  fmt.Sprintf("IdentityInheritedEntitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SecretOutputValidationError{
+						field:  fmt.Sprintf("IdentityInheritedEntitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SecretOutputValidationError{
+					field:  fmt.Sprintf("IdentityInheritedEntitlements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SecretOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// SecretOutputMultiError is an error wrapping multiple validation errors
+// returned by SecretOutput.ValidateAll() if the designated constraints aren't met.
+type SecretOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SecretOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SecretOutputMultiError) AllErrors() []error { return m }
+
+// SecretOutputValidationError is the validation error returned by
+// SecretOutput.Validate if the designated constraints aren't met.
+type SecretOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecretOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecretOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecretOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecretOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecretOutputValidationError) ErrorName() string { return "SecretOutputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SecretOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecretOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecretOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecretOutputValidationError{}
+
+// Validate checks the field values on SecretsReportOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SecretsReportOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SecretsReportOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SecretsReportOutputMultiError, or nil if none found.
+func (m *SecretsReportOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SecretsReportOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ExpiringDays
+
+	for idx, item := range m.GetSecrets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SecretsReportOutputValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SecretsReportOutputValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SecretsReportOutputValidationError{
+					field:  fmt.Sprintf("Secrets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SecretsReportOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// SecretsReportOutputMultiError is an error wrapping multiple validation
+// errors returned by SecretsReportOutput.ValidateAll() if the designated
+// constraints aren't met.
+type SecretsReportOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SecretsReportOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SecretsReportOutputMultiError) AllErrors() []error { return m }
+
+// SecretsReportOutputValidationError is the validation error returned by
+// SecretsReportOutput.Validate if the designated constraints aren't met.
+type SecretsReportOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecretsReportOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecretsReportOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecretsReportOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecretsReportOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecretsReportOutputValidationError) ErrorName() string {
+	return "SecretsReportOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SecretsReportOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecretsReportOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecretsReportOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecretsReportOutputValidationError{}
+
+// Validate checks the field values on ServiceAccountOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ServiceAccountOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ServiceAccountOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ServiceAccountOutputMultiError, or nil if none found.
+func (m *ServiceAccountOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ServiceAccountOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for File
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceAccountOutputValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceAccountOutputValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceAccountOutputValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResourceType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceAccountOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceAccountOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResourceType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceAccountOutputValidationError{
+				field:  "ResourceType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AccountType
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceAccountOutputValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceAccountOutputValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceAccountOutputValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastLogin()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceAccountOutputValidationError{
+					field:  "LastLogin",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceAccountOutputValidationError{
+					field:  "LastLogin",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastLogin()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceAccountOutputValidationError{
+				field:  "LastLogin",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSecrets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ServiceAccountOutputValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ServiceAccountOutputValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ServiceAccountOutputValidationError{
+					field:  fmt.Sprintf("Secrets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetEntitlements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ServiceAccountOutputValidationError{
+						field:  fmt.Sprintf("Entitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ServiceAccountOutputValidationError{
+						field:  fmt.Sprintf("Entitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ServiceAccountOutputValidationError{
+					field:  fmt.Sprintf("Entitlements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetInheritedEntitlements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ServiceAccountOutputValidationError{
+						field:  fmt.Sprintf("InheritedEntitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ServiceAccountOutputValidationError{
+						field:  fmt.Sprintf("InheritedEntitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ServiceAccountOutputValidationError{
+					field:  fmt.Sprintf("InheritedEntitlements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPrivilegedEntitlements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ServiceAccountOutputValidationError{
+						field:  fmt.Sprintf("PrivilegedEntitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ServiceAccountOutputValidationError{
+						field:  fmt.Sprintf("PrivilegedEntitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ServiceAccountOutputValidationError{
+					field:  fmt.Sprintf("PrivilegedEntitlements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ServiceAccountOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// ServiceAccountOutputMultiError is an error wrapping multiple validation
+// errors returned by ServiceAccountOutput.ValidateAll() if the designated
+// constraints aren't met.
+type ServiceAccountOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ServiceAccountOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ServiceAccountOutputMultiError) AllErrors() []error { return m }
+
+// ServiceAccountOutputValidationError is the validation error returned by
+// ServiceAccountOutput.Validate if the designated constraints aren't met.
+type ServiceAccountOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServiceAccountOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServiceAccountOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServiceAccountOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServiceAccountOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServiceAccountOutputValidationError) ErrorName() string {
+	return "ServiceAccountOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ServiceAccountOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServiceAccountOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServiceAccountOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServiceAccountOutputValidationError{}
+
+// Validate checks the field values on ServiceAccountsReportOutput with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ServiceAccountsReportOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ServiceAccountsReportOutput with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ServiceAccountsReportOutputMultiError, or nil if none found.
+func (m *ServiceAccountsReportOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ServiceAccountsReportOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetServiceAccounts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ServiceAccountsReportOutputValidationError{
+						field:  fmt.Sprintf("ServiceAccounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ServiceAccountsReportOutputValidationError{
+						field:  fmt.Sprintf("ServiceAccounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ServiceAccountsReportOutputValidationError{
+					field:  fmt.Sprintf("ServiceAccounts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ServiceAccountsReportOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// ServiceAccountsReportOutputMultiError is an error wrapping multiple
+// validation errors returned by ServiceAccountsReportOutput.ValidateAll() if
+// the designated constraints aren't met.
+type ServiceAccountsReportOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ServiceAccountsReportOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ServiceAccountsReportOutputMultiError) AllErrors() []error { return m }
+
+// ServiceAccountsReportOutputValidationError is the validation error returned
+// by ServiceAccountsReportOutput.Validate if the designated constraints
+// aren't met.
+type ServiceAccountsReportOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServiceAccountsReportOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServiceAccountsReportOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServiceAccountsReportOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServiceAccountsReportOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServiceAccountsReportOutputValidationError) ErrorName() string {
+	return "ServiceAccountsReportOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ServiceAccountsReportOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServiceAccountsReportOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServiceAccountsReportOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServiceAccountsReportOutputValidationError{}
+
+// Validate checks the field values on InsightTargetOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InsightTargetOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InsightTargetOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InsightTargetOutputMultiError, or nil if none found.
+func (m *InsightTargetOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InsightTargetOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for File
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InsightTargetOutputValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InsightTargetOutputValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InsightTargetOutputValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResourceType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InsightTargetOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InsightTargetOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResourceType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InsightTargetOutputValidationError{
+				field:  "ResourceType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetEntitlements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InsightTargetOutputValidationError{
+						field:  fmt.Sprintf("Entitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InsightTargetOutputValidationError{
+						field:  fmt.Sprintf("Entitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InsightTargetOutputValidationError{
+					field:  fmt.Sprintf("Entitlements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetInheritedEntitlements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InsightTargetOutputValidationError{
+						field:  fmt.Sprintf("InheritedEntitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InsightTargetOutputValidationError{
+						field:  fmt.Sprintf("InheritedEntitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InsightTargetOutputValidationError{
+					field:  fmt.Sprintf("InheritedEntitlements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InsightTargetOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// InsightTargetOutputMultiError is an error wrapping multiple validation
+// errors returned by InsightTargetOutput.ValidateAll() if the designated
+// constraints aren't met.
+type InsightTargetOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InsightTargetOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InsightTargetOutputMultiError) AllErrors() []error { return m }
+
+// InsightTargetOutputValidationError is the validation error returned by
+// InsightTargetOutput.Validate if the designated constraints aren't met.
+type InsightTargetOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InsightTargetOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InsightTargetOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InsightTargetOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InsightTargetOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InsightTargetOutputValidationError) ErrorName() string {
+	return "InsightTargetOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InsightTargetOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInsightTargetOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InsightTargetOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InsightTargetOutputValidationError{}
+
+// Validate checks the field values on InsightOutput with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *InsightOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InsightOutput with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InsightOutputMultiError, or
+// nil if none found.
+func (m *InsightOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InsightOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for File
+
+	if all {
+		switch v := interface{}(m.GetInsight()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InsightOutputValidationError{
+					field:  "Insight",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InsightOutputValidationError{
+					field:  "Insight",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInsight()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InsightOutputValidationError{
+				field:  "Insight",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResourceType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InsightOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InsightOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResourceType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InsightOutputValidationError{
+				field:  "ResourceType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for InsightType
+
+	// no validation rules for Value
+
+	// no validation rules for NormalizedScore
+
+	// no validation rules for Severity
+
+	if all {
+		switch v := interface{}(m.GetObservedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InsightOutputValidationError{
+					field:  "ObservedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InsightOutputValidationError{
+					field:  "ObservedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetObservedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InsightOutputValidationError{
+				field:  "ObservedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TargetType
+
+	// no validation rules for Target
+
+	for idx, item := range m.GetTargets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InsightOutputValidationError{
+						field:  fmt.Sprintf("Targets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InsightOutputValidationError{
+						field:  fmt.Sprintf("Targets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InsightOutputValidationError{
+					field:  fmt.Sprintf("Targets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InsightOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// InsightOutputMultiError is an error wrapping multiple validation errors
+// returned by InsightOutput.ValidateAll() if the designated constraints
+// aren't met.
+type InsightOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InsightOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InsightOutputMultiError) AllErrors() []error { return m }
+
+// InsightOutputValidationError is the validation error returned by
+// InsightOutput.Validate if the designated constraints aren't met.
+type InsightOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InsightOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InsightOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InsightOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InsightOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InsightOutputValidationError) ErrorName() string { return "InsightOutputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InsightOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInsightOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InsightOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InsightOutputValidationError{}
+
+// Validate checks the field values on InsightsOutput with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *InsightsOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InsightsOutput with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InsightsOutputMultiError,
+// or nil if none found.
+func (m *InsightsOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InsightsOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetInsights() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InsightsOutputValidationError{
+						field:  fmt.Sprintf("Insights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InsightsOutputValidationError{
+						field:  fmt.Sprintf("Insights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InsightsOutputValidationError{
+					field:  fmt.Sprintf("Insights[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Unresolved
+
+	if len(errors) > 0 {
+		return InsightsOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// InsightsOutputMultiError is an error wrapping multiple validation errors
+// returned by InsightsOutput.ValidateAll() if the designated constraints
+// aren't met.
+type InsightsOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InsightsOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InsightsOutputMultiError) AllErrors() []error { return m }
+
+// InsightsOutputValidationError is the validation error returned by
+// InsightsOutput.Validate if the designated constraints aren't met.
+type InsightsOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InsightsOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InsightsOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InsightsOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InsightsOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InsightsOutputValidationError) ErrorName() string { return "InsightsOutputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InsightsOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInsightsOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InsightsOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InsightsOutputValidationError{}
+
+// Validate checks the field values on OutlierOutput with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OutlierOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OutlierOutput with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OutlierOutputMultiError, or
+// nil if none found.
+func (m *OutlierOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OutlierOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for File
+
+	// no validation rules for PeerGroup
+
+	// no validation rules for PeerGroupSize
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OutlierOutputValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OutlierOutputValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OutlierOutputValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResourceType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OutlierOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OutlierOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResourceType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OutlierOutputValidationError{
+				field:  "ResourceType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEntitlement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OutlierOutputValidationError{
+					field:  "Entitlement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OutlierOutputValidationError{
+					field:  "Entitlement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntitlement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OutlierOutputValidationError{
+				field:  "Entitlement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Holders
+
+	// no validation rules for HoldersPercent
+
+	if len(errors) > 0 {
+		return OutlierOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// OutlierOutputMultiError is an error wrapping multiple validation errors
+// returned by OutlierOutput.ValidateAll() if the designated constraints
+// aren't met.
+type OutlierOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OutlierOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OutlierOutputMultiError) AllErrors() []error { return m }
+
+// OutlierOutputValidationError is the validation error returned by
+// OutlierOutput.Validate if the designated constraints aren't met.
+type OutlierOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OutlierOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OutlierOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OutlierOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OutlierOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OutlierOutputValidationError) ErrorName() string { return "OutlierOutputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OutlierOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOutlierOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OutlierOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OutlierOutputValidationError{}
+
+// Validate checks the field values on OutliersOutput with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OutliersOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OutliersOutput with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OutliersOutputMultiError,
+// or nil if none found.
+func (m *OutliersOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OutliersOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GroupBy
+
+	// no validation rules for Threshold
+
+	// no validation rules for Baseline
+
+	// no validation rules for PeerGroups
+
+	for idx, item := range m.GetRare() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OutliersOutputValidationError{
+						field:  fmt.Sprintf("Rare[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OutliersOutputValidationError{
+						field:  fmt.Sprintf("Rare[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OutliersOutputValidationError{
+					field:  fmt.Sprintf("Rare[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetMissingBaseline() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OutliersOutputValidationError{
+						field:  fmt.Sprintf("MissingBaseline[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OutliersOutputValidationError{
+						field:  fmt.Sprintf("MissingBaseline[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OutliersOutputValidationError{
+					field:  fmt.Sprintf("MissingBaseline[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OutliersOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// OutliersOutputMultiError is an error wrapping multiple validation errors
+// returned by OutliersOutput.ValidateAll() if the designated constraints
+// aren't met.
+type OutliersOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OutliersOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OutliersOutputMultiError) AllErrors() []error { return m }
+
+// OutliersOutputValidationError is the validation error returned by
+// OutliersOutput.Validate if the designated constraints aren't met.
+type OutliersOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OutliersOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OutliersOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OutliersOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OutliersOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OutliersOutputValidationError) ErrorName() string { return "OutliersOutputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OutliersOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOutliersOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OutliersOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OutliersOutputValidationError{}
+
+// Validate checks the field values on RoleCandidateUserOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RoleCandidateUserOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoleCandidateUserOutput with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RoleCandidateUserOutputMultiError, or nil if none found.
+func (m *RoleCandidateUserOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoleCandidateUserOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoleCandidateUserOutputValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoleCandidateUserOutputValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoleCandidateUserOutputValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetExceptions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RoleCandidateUserOutputValidationError{
+						field:  fmt.Sprintf("Exceptions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RoleCandidateUserOutputValidationError{
+						field:  fmt.Sprintf("Exceptions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RoleCandidateUserOutputValidationError{
+					field:  fmt.Sprintf("Exceptions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RoleCandidateUserOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoleCandidateUserOutputMultiError is an error wrapping multiple validation
+// errors returned by RoleCandidateUserOutput.ValidateAll() if the designated
+// constraints aren't met.
+type RoleCandidateUserOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoleCandidateUserOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoleCandidateUserOutputMultiError) AllErrors() []error { return m }
+
+// RoleCandidateUserOutputValidationError is the validation error returned by
+// RoleCandidateUserOutput.Validate if the designated constraints aren't met.
+type RoleCandidateUserOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoleCandidateUserOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoleCandidateUserOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoleCandidateUserOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoleCandidateUserOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoleCandidateUserOutputValidationError) ErrorName() string {
+	return "RoleCandidateUserOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RoleCandidateUserOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoleCandidateUserOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoleCandidateUserOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoleCandidateUserOutputValidationError{}
+
+// Validate checks the field values on RoleCandidateOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RoleCandidateOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoleCandidateOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RoleCandidateOutputMultiError, or nil if none found.
+func (m *RoleCandidateOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoleCandidateOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEntitlements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RoleCandidateOutputValidationError{
+						field:  fmt.Sprintf("Entitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RoleCandidateOutputValidationError{
+						field:  fmt.Sprintf("Entitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RoleCandidateOutputValidationError{
+					field:  fmt.Sprintf("Entitlements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for UsersCovered
+
+	// no validation rules for CoveragePercent
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RoleCandidateOutputValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RoleCandidateOutputValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RoleCandidateOutputValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RoleCandidateOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoleCandidateOutputMultiError is an error wrapping multiple validation
+// errors returned by RoleCandidateOutput.ValidateAll() if the designated
+// constraints aren't met.
+type RoleCandidateOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoleCandidateOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoleCandidateOutputMultiError) AllErrors() []error { return m }
+
+// RoleCandidateOutputValidationError is the validation error returned by
+// RoleCandidateOutput.Validate if the designated constraints aren't met.
+type RoleCandidateOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoleCandidateOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoleCandidateOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoleCandidateOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoleCandidateOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoleCandidateOutputValidationError) ErrorName() string {
+	return "RoleCandidateOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RoleCandidateOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoleCandidateOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoleCandidateOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoleCandidateOutputValidationError{}
+
+// Validate checks the field values on RoleMiningOutput with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RoleMiningOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoleMiningOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RoleMiningOutputMultiError, or nil if none found.
+func (m *RoleMiningOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoleMiningOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for File
+
+	// no validation rules for MinSupport
+
+	// no validation rules for Users
+
+	for idx, item := range m.GetCandidates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RoleMiningOutputValidationError{
+						field:  fmt.Sprintf("Candidates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RoleMiningOutputValidationError{
+						field:  fmt.Sprintf("Candidates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RoleMiningOutputValidationError{
+					field:  fmt.Sprintf("Candidates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RoleMiningOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoleMiningOutputMultiError is an error wrapping multiple validation errors
+// returned by RoleMiningOutput.ValidateAll() if the designated constraints
+// aren't met.
+type RoleMiningOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoleMiningOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoleMiningOutputMultiError) AllErrors() []error { return m }
+
+// RoleMiningOutputValidationError is the validation error returned by
+// RoleMiningOutput.Validate if the designated constraints aren't met.
+type RoleMiningOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoleMiningOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoleMiningOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoleMiningOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoleMiningOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoleMiningOutputValidationError) ErrorName() string { return "RoleMiningOutputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoleMiningOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoleMiningOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoleMiningOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoleMiningOutputValidationError{}
+
+// Validate checks the field values on ResourceTreeNode with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ResourceTreeNode) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResourceTreeNode with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResourceTreeNodeMultiError, or nil if none found.
+func (m *ResourceTreeNode) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResourceTreeNode) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResourceTreeNodeValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResourceTreeNodeValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResourceTreeNodeValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResourceType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResourceTreeNodeValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResourceTreeNodeValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResourceType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResourceTreeNodeValidationError{
+				field:  "ResourceType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Entitlements
+
+	// no validation rules for Grants
+
+	// no validation rules for Truncated
+
+	for idx, item := range m.GetChildren() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ResourceTreeNodeValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ResourceTreeNodeValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ResourceTreeNodeValidationError{
+					field:  fmt.Sprintf("Children[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ResourceTreeNodeMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResourceTreeNodeMultiError is an error wrapping multiple validation errors
+// returned by ResourceTreeNode.ValidateAll() if the designated constraints
+// aren't met.
+type ResourceTreeNodeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResourceTreeNodeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResourceTreeNodeMultiError) AllErrors() []error { return m }
+
+// ResourceTreeNodeValidationError is the validation error returned by
+// ResourceTreeNode.Validate if the designated constraints aren't met.
+type ResourceTreeNodeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResourceTreeNodeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResourceTreeNodeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResourceTreeNodeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResourceTreeNodeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResourceTreeNodeValidationError) ErrorName() string { return "ResourceTreeNodeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResourceTreeNodeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResourceTreeNode.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResourceTreeNodeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResourceTreeNodeValidationError{}
+
+// Validate checks the field values on ResourceTreeOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResourceTreeOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResourceTreeOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResourceTreeOutputMultiError, or nil if none found.
+func (m *ResourceTreeOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResourceTreeOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRoots() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ResourceTreeOutputValidationError{
+						field:  fmt.Sprintf("Roots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ResourceTreeOutputValidationError{
+						field:  fmt.Sprintf("Roots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ResourceTreeOutputValidationError{
+					field:  fmt.Sprintf("Roots[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ResourceTreeOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResourceTreeOutputMultiError is an error wrapping multiple validation errors
+// returned by ResourceTreeOutput.ValidateAll() if the designated constraints
+// aren't met.
+type ResourceTreeOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResourceTreeOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResourceTreeOutputMultiError) AllErrors() []error { return m }
+
+// ResourceTreeOutputValidationError is the validation error returned by
+// ResourceTreeOutput.Validate if the designated constraints aren't met.
+type ResourceTreeOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResourceTreeOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResourceTreeOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResourceTreeOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResourceTreeOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResourceTreeOutputValidationError) ErrorName() string {
+	return "ResourceTreeOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResourceTreeOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResourceTreeOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResourceTreeOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResourceTreeOutputValidationError{}
+
+// Validate checks the field values on GroupAnalysisOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GroupAnalysisOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupAnalysisOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupAnalysisOutputMultiError, or nil if none found.
+func (m *GroupAnalysisOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupAnalysisOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GroupAnalysisOutputValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GroupAnalysisOutputValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GroupAnalysisOutputValidationError{
+				field:  "Group",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResourceType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GroupAnalysisOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GroupAnalysisOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResourceType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GroupAnalysisOutputValidationError{
+				field:  "ResourceType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DirectMembers
+
+	// no validation rules for DirectGroups
+
+	// no validation rules for EffectiveMembers
+
+	// no validation rules for NestingDepth
+
+	// no validation rules for InCycle
+
+	if len(errors) > 0 {
+		return GroupAnalysisOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupAnalysisOutputMultiError is an error wrapping multiple validation
+// errors returned by GroupAnalysisOutput.ValidateAll() if the designated
+// constraints aren't met.
+type GroupAnalysisOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupAnalysisOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupAnalysisOutputMultiError) AllErrors() []error { return m }
+
+// GroupAnalysisOutputValidationError is the validation error returned by
+// GroupAnalysisOutput.Validate if the designated constraints aren't met.
+type GroupAnalysisOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupAnalysisOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupAnalysisOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupAnalysisOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupAnalysisOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupAnalysisOutputValidationError) ErrorName() string {
+	return "GroupAnalysisOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GroupAnalysisOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupAnalysisOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupAnalysisOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupAnalysisOutputValidationError{}
+
+// Validate checks the field values on GroupCycleOutput with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GroupCycleOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupCycleOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupCycleOutputMultiError, or nil if none found.
+func (m *GroupCycleOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupCycleOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetGroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GroupCycleOutputValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GroupCycleOutputValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GroupCycleOutputValidationError{
+					field:  fmt.Sprintf("Groups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GroupCycleOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupCycleOutputMultiError is an error wrapping multiple validation errors
+// returned by GroupCycleOutput.ValidateAll() if the designated constraints
+// aren't met.
+type GroupCycleOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupCycleOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupCycleOutputMultiError) AllErrors() []error { return m }
+
+// GroupCycleOutputValidationError is the validation error returned by
+// GroupCycleOutput.Validate if the designated constraints aren't met.
+type GroupCycleOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupCycleOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupCycleOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupCycleOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupCycleOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupCycleOutputValidationError) ErrorName() string { return "GroupCycleOutputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GroupCycleOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupCycleOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupCycleOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupCycleOutputValidationError{}
+
+// Validate checks the field values on GroupsAnalysisOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GroupsAnalysisOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupsAnalysisOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupsAnalysisOutputMultiError, or nil if none found.
+func (m *GroupsAnalysisOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupsAnalysisOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for File
+
+	// no validation rules for Groups
+
+	// no validation rules for MaxNestingDepth
+
+	for idx, item := range m.GetNested() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GroupsAnalysisOutputValidationError{
+						field:  fmt.Sprintf("Nested[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GroupsAnalysisOutputValidationError{
+						field:  fmt.Sprintf("Nested[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GroupsAnalysisOutputValidationError{
+					field:  fmt.Sprintf("Nested[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetCycles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GroupsAnalysisOutputValidationError{
+						field:  fmt.Sprintf("Cycles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GroupsAnalysisOutputValidationError{
+						field:  fmt.Sprintf("Cycles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GroupsAnalysisOutputValidationError{
+					field:  fmt.Sprintf("Cycles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetFanOut() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GroupsAnalysisOutputValidationError{
+						field:  fmt.Sprintf("FanOut[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GroupsAnalysisOutputValidationError{
+						field:  fmt.Sprintf("FanOut[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GroupsAnalysisOutputValidationError{
+					field:  fmt.Sprintf("FanOut[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetEmpty() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GroupsAnalysisOutputValidationError{
+						field:  fmt.Sprintf("Empty[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GroupsAnalysisOutputValidationError{
+						field:  fmt.Sprintf("Empty[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GroupsAnalysisOutputValidationError{
+					field:  fmt.Sprintf("Empty[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GroupsAnalysisOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupsAnalysisOutputMultiError is an error wrapping multiple validation
+// errors returned by GroupsAnalysisOutput.ValidateAll() if the designated
+// constraints aren't met.
+type GroupsAnalysisOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupsAnalysisOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupsAnalysisOutputMultiError) AllErrors() []error { return m }
+
+// GroupsAnalysisOutputValidationError is the validation error returned by
+// GroupsAnalysisOutput.Validate if the designated constraints aren't met.
+type GroupsAnalysisOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupsAnalysisOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupsAnalysisOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupsAnalysisOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupsAnalysisOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupsAnalysisOutputValidationError) ErrorName() string {
+	return "GroupsAnalysisOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GroupsAnalysisOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupsAnalysisOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupsAnalysisOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupsAnalysisOutputValidationError{}
+
+// Validate checks the field values on PrincipalTypeCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PrincipalTypeCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PrincipalTypeCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PrincipalTypeCountMultiError, or nil if none found.
+func (m *PrincipalTypeCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PrincipalTypeCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetResourceType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PrincipalTypeCountValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PrincipalTypeCountValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResourceType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PrincipalTypeCountValidationError{
+				field:  "ResourceType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Direct
+
+	// no validation rules for Effective
+
+	if len(errors) > 0 {
+		return PrincipalTypeCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// PrincipalTypeCountMultiError is an error wrapping multiple validation errors
+// returned by PrincipalTypeCount.ValidateAll() if the designated constraints
+// aren't met.
+type PrincipalTypeCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PrincipalTypeCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PrincipalTypeCountMultiError) AllErrors() []error { return m }
+
+// PrincipalTypeCountValidationError is the validation error returned by
+// PrincipalTypeCount.Validate if the designated constraints aren't met.
+type PrincipalTypeCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PrincipalTypeCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PrincipalTypeCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PrincipalTypeCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PrincipalTypeCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PrincipalTypeCountValidationError) ErrorName() string {
+	return "PrincipalTypeCountValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PrincipalTypeCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPrincipalTypeCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PrincipalTypeCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PrincipalTypeCountValidationError{}
+
+// Validate checks the field values on EntitlementUsageOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EntitlementUsageOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EntitlementUsageOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EntitlementUsageOutputMultiError, or nil if none found.
+func (m *EntitlementUsageOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EntitlementUsageOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for File
+
+	if all {
+		switch v := interface{}(m.GetEntitlement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EntitlementUsageOutputValidationError{
+					field:  "Entitlement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EntitlementUsageOutputValidationError{
+					field:  "Entitlement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntitlement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EntitlementUsageOutputValidationError{
+				field:  "Entitlement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EntitlementUsageOutputValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EntitlementUsageOutputValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EntitlementUsageOutputValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResourceType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EntitlementUsageOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EntitlementUsageOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResourceType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EntitlementUsageOutputValidationError{
+				field:  "ResourceType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DirectHolders
+
+	// no validation rules for EffectiveHolders
+
+	for idx, item := range m.GetByPrincipalType() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EntitlementUsageOutputValidationError{
+						field:  fmt.Sprintf("ByPrincipalType[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EntitlementUsageOutputValidationError{
+						field:  fmt.Sprintf("ByPrincipalType[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EntitlementUsageOutputValidationError{
+					field:  fmt.Sprintf("ByPrincipalType[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return EntitlementUsageOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// EntitlementUsageOutputMultiError is an error wrapping multiple validation
+// errors returned by EntitlementUsageOutput.ValidateAll() if the designated
+// constraints aren't met.
+type EntitlementUsageOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EntitlementUsageOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EntitlementUsageOutputMultiError) AllErrors() []error { return m }
+
+// EntitlementUsageOutputValidationError is the validation error returned by
+// EntitlementUsageOutput.Validate if the designated constraints aren't met.
+type EntitlementUsageOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EntitlementUsageOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EntitlementUsageOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EntitlementUsageOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EntitlementUsageOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EntitlementUsageOutputValidationError) ErrorName() string {
+	return "EntitlementUsageOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EntitlementUsageOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEntitlementUsageOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EntitlementUsageOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EntitlementUsageOutputValidationError{}
+
+// Validate checks the field values on EntitlementUsageReportOutput with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EntitlementUsageReportOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EntitlementUsageReportOutput with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EntitlementUsageReportOutputMultiError, or nil if none found.
+func (m *EntitlementUsageReportOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EntitlementUsageReportOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Entitlements
+
+	for idx, item := range m.GetUnused() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EntitlementUsageReportOutputValidationError{
+						field:  fmt.Sprintf("Unused[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EntitlementUsageReportOutputValidationError{
+						field:  fmt.Sprintf("Unused[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EntitlementUsageReportOutputValidationError{
+					field:  fmt.Sprintf("Unused[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSingleHolder() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EntitlementUsageReportOutputValidationError{
+						field:  fmt.Sprintf("SingleHolder[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EntitlementUsageReportOutputValidationError{
+						field:  fmt.Sprintf("SingleHolder[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EntitlementUsageReportOutputValidationError{
+					field:  fmt.Sprintf("SingleHolder[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetMostGranted() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EntitlementUsageReportOutputValidationError{
+						field:  fmt.Sprintf("MostGranted[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EntitlementUsageReportOutputValidationError{
+						field:  fmt.Sprintf("MostGranted[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EntitlementUsageReportOutputValidationError{
+					field:  fmt.Sprintf("MostGranted[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetAll() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EntitlementUsageReportOutputValidationError{
+						field:  fmt.Sprintf("All[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EntitlementUsageReportOutputValidationError{
+						field:  fmt.Sprintf("All[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EntitlementUsageReportOutputValidationError{
+					field:  fmt.Sprintf("All[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return EntitlementUsageReportOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// EntitlementUsageReportOutputMultiError is an error wrapping multiple
+// validation errors returned by EntitlementUsageReportOutput.ValidateAll() if
+// the designated constraints aren't met.
+type EntitlementUsageReportOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EntitlementUsageReportOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EntitlementUsageReportOutputMultiError) AllErrors() []error { return m }
+
+// EntitlementUsageReportOutputValidationError is the validation error returned
+// by EntitlementUsageReportOutput.Validate if the designated constraints
+// aren't met.
+type EntitlementUsageReportOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EntitlementUsageReportOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EntitlementUsageReportOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EntitlementUsageReportOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EntitlementUsageReportOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EntitlementUsageReportOutputValidationError) ErrorName() string {
+	return "EntitlementUsageReportOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EntitlementUsageReportOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEntitlementUsageReportOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EntitlementUsageReportOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EntitlementUsageReportOutputValidationError{}
+
+// Validate checks the field values on PersonAccountOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PersonAccountOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PersonAccountOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PersonAccountOutputMultiError, or nil if none found.
+func (m *PersonAccountOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PersonAccountOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for File
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PersonAccountOutputValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PersonAccountOutputValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PersonAccountOutputValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResourceType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PersonAccountOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PersonAccountOutputValidationError{
+					field:  "ResourceType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResourceType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PersonAccountOutputValidationError{
+				field:  "ResourceType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Email
+
+	// no validation rules for Login
+
+	// no validation rules for Status
+
+	// no validation rules for MatchedBy
+
+	for idx, item := range m.GetEntitlements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PersonAccountOutputValidationError{
+						field:  fmt.Sprintf("Entitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PersonAccountOutputValidationError{
+						field:  fmt.Sprintf("Entitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PersonAccountOutputValidationError{
+					field:  fmt.Sprintf("Entitlements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetInheritedEntitlements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PersonAccountOutputValidationError{
+						field:  fmt.Sprintf("InheritedEntitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PersonAccountOutputValidationError{
+						field:  fmt.Sprintf("InheritedEntitlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PersonAccountOutputValidationError{
+					field:  fmt.Sprintf("InheritedEntitlements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PersonAccountOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// PersonAccountOutputMultiError is an error wrapping multiple validation
+// errors returned by PersonAccountOutput.ValidateAll() if the designated
+// constraints aren't met.
+type PersonAccountOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PersonAccountOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PersonAccountOutputMultiError) AllErrors() []error { return m }
+
+// PersonAccountOutputValidationError is the validation error returned by
+// PersonAccountOutput.Validate if the designated constraints aren't met.
+type PersonAccountOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PersonAccountOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PersonAccountOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PersonAccountOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PersonAccountOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PersonAccountOutputValidationError) ErrorName() string {
+	return "PersonAccountOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PersonAccountOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPersonAccountOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PersonAccountOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PersonAccountOutputValidationError{}
+
+// Validate checks the field values on PersonOutput with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PersonOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PersonOutput with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PersonOutputMultiError, or
+// nil if none found.
+func (m *PersonOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PersonOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	// no validation rules for DisplayName
+
+	for idx, item := range m.GetAccounts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PersonOutputValidationError{
+						field:  fmt.Sprintf("Accounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PersonOutputValidationError{
+						field:  fmt.Sprintf("Accounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PersonOutputValidationError{
+					field:  fmt.Sprintf("Accounts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PersonOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// PersonOutputMultiError is an error wrapping multiple validation errors
+// returned by PersonOutput.ValidateAll() if the designated constraints aren't met.
+type PersonOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PersonOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PersonOutputMultiError) AllErrors() []error { return m }
+
+// PersonOutputValidationError is the validation error returned by
+// PersonOutput.Validate if the designated constraints aren't met.
+type PersonOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PersonOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PersonOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PersonOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PersonOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PersonOutputValidationError) ErrorName() string { return "PersonOutputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PersonOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPersonOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PersonOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PersonOutputValidationError{}
+
+// Validate checks the field values on ReconcileMappingOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReconcileMappingOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReconcileMappingOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReconcileMappingOutputMultiError, or nil if none found.
+func (m *ReconcileMappingOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReconcileMappingOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetSourceEntitlement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReconcileMappingOutputValidationError{
+					field:  "SourceEntitlement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReconcileMappingOutputValidationError{
+					field:  "SourceEntitlement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSourceEntitlement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReconcileMappingOutputValidationError{
+				field:  "SourceEntitlement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTargetEntitlement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReconcileMappingOutputValidationError{
+					field:  "TargetEntitlement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReconcileMappingOutputValidationError{
+					field:  "TargetEntitlement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTargetEntitlement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReconcileMappingOutputValidationError{
+				field:  "TargetEntitlement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SourcePrincipals
+
+	// no validation rules for TargetPrincipals
+
+	// no validation rules for Matched
+
+	for idx, item := range m.GetMissing() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReconcileMappingOutputValidationError{
+						field:  fmt.Sprintf("Missing[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReconcileMappingOutputValidationError{
+						field:  fmt.Sprintf("Missing[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReconcileMappingOutputValidationError{
+					field:  fmt.Sprintf("Missing[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetExtra() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReconcileMappingOutputValidationError{
+						field:  fmt.Sprintf("Extra[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReconcileMappingOutputValidationError{
+						field:  fmt.Sprintf("Extra[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReconcileMappingOutputValidationError{
+					field:  fmt.Sprintf("Extra[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReconcileMappingOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReconcileMappingOutputMultiError is an error wrapping multiple validation
+// errors returned by ReconcileMappingOutput.ValidateAll() if the designated
+// constraints aren't met.
+type ReconcileMappingOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReconcileMappingOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReconcileMappingOutputMultiError) AllErrors() []error { return m }
+
+// ReconcileMappingOutputValidationError is the validation error returned by
+// ReconcileMappingOutput.Validate if the designated constraints aren't met.
+type ReconcileMappingOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReconcileMappingOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReconcileMappingOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReconcileMappingOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReconcileMappingOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReconcileMappingOutputValidationError) ErrorName() string {
+	return "ReconcileMappingOutputValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReconcileMappingOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReconcileMappingOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReconcileMappingOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReconcileMappingOutputValidationError{}
+
+// Validate checks the field values on ReconcileOutput with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReconcileOutput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReconcileOutput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReconcileOutputMultiError, or nil if none found.
+func (m *ReconcileOutput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReconcileOutput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SourceFile
+
+	// no validation rules for TargetFile
+
+	for idx, item := range m.GetMappings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReconcileOutputValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReconcileOutputValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReconcileOutputValidationError{
+					field:  fmt.Sprintf("Mappings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for MappingsInSync
+
+	// no validation rules for TotalMissing
+
+	// no validation rules for TotalExtra
+
+	if len(errors) > 0 {
+		return ReconcileOutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReconcileOutputMultiError is an error wrapping multiple validation errors
+// returned by ReconcileOutput.ValidateAll() if the designated constraints
+// aren't met.
+type ReconcileOutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReconcileOutputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReconcileOutputMultiError) AllErrors() []error { return m }
+
+// ReconcileOutputValidationError is the validation error returned by
+// ReconcileOutput.Validate if the designated constraints aren't met.
+type ReconcileOutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReconcileOutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReconcileOutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReconcileOutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReconcileOutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReconcileOutputValidationError) ErrorName() string { return "ReconcileOutputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReconcileOutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReconcileOutput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReconcileOutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReconcileOutputValidationError{}
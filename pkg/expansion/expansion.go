@@ -0,0 +1,524 @@
+package expansion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
+	"github.com/conductorone/baton-sdk/pkg/annotations"
+	"github.com/conductorone/baton-sdk/pkg/connectorstore"
+)
+
+// internalGrantLister is implemented by c1z files that store GrantExpandable annotations in their own column
+// rather than on the grant payload.
+type internalGrantLister interface {
+	ListGrantsInternal(ctx context.Context, opts connectorstore.GrantListOptions) (*connectorstore.InternalGrantListResponse, error)
+}
+
+// Edge records that every holder of SourceEntitlementID also receives TargetEntitlementID.
+// Edges are declared by a GrantExpandable annotation on the grant identified by GrantID.
+type Edge struct {
+	GrantID             string
+	SourceEntitlementID string
+	TargetEntitlementID string
+	Principal           *v2.ResourceId
+	Shallow             bool
+	ResourceTypeIDs     []string
+}
+
+// Access describes how a principal holds an entitlement.
+type Access struct {
+	EntitlementID string
+	// Direct is set when the principal holds a grant for the entitlement itself.
+	Direct bool
+	// Grant is the principal's direct grant for the entitlement, if any.
+	Grant *v2.Grant
+	// Via holds the edges that passed the entitlement on to the principal.
+	Via []*Edge
+	// Depth is the smallest number of expansion hops needed to reach the entitlement.
+	Depth int
+}
+
+// Holder is a principal that effectively holds an entitlement.
+type Holder struct {
+	Principal *v2.ResourceId
+	Access    *Access
+}
+
+// Graph is an in-memory view of the direct grants in a c1z along with the expansion edges between entitlements.
+// Grants that were produced by a previous expansion are ignored so that results are the same whether or not
+// the file was synced with grant expansion enabled.
+type Graph struct {
+	entitlements        map[string]*v2.Entitlement
+	resources           map[string][]string
+	principals          map[string]*v2.ResourceId
+	grantsByEntitlement map[string][]*v2.Grant
+	grantsByPrincipal   map[string][]*v2.Grant
+	edgesBySource       map[string][]*Edge
+	edgesByTarget       map[string][]*Edge
+	sourcedGrants       map[string]*v2.Grant
+
+	accessCache map[string][]*Access
+}
+
+// ResourceKey returns a stable key for a resource ID.
+func ResourceKey(id *v2.ResourceId) string {
+	return fmt.Sprintf("%s:%s", id.ResourceType, id.Resource)
+}
+
+// IsDirect reports whether a grant was produced by the connector rather than by grant expansion.
+// Expanded grants list the entitlements they came from in their sources, and a grant that was also
+// held directly lists its own entitlement.
+func IsDirect(g *v2.Grant) bool {
+	sources := g.GetSources().GetSources()
+	if len(sources) == 0 {
+		return true
+	}
+
+	_, ok := sources[g.GetEntitlement().GetId()]
+	return ok
+}
+
+func sourcedGrantKey(principalKey string, entitlementID string) string {
+	return principalKey + "/" + entitlementID
+}
+
+func newGraph() *Graph {
+	return &Graph{
+		entitlements:        make(map[string]*v2.Entitlement),
+		resources:           make(map[string][]string),
+		principals:          make(map[string]*v2.ResourceId),
+		grantsByEntitlement: make(map[string][]*v2.Grant),
+		grantsByPrincipal:   make(map[string][]*v2.Grant),
+		edgesBySource:       make(map[string][]*Edge),
+		edgesByTarget:       make(map[string][]*Edge),
+		sourcedGrants:       make(map[string]*v2.Grant),
+		accessCache:         make(map[string][]*Access),
+	}
+}
+
+// Load reads every grant from the store and builds the expansion graph.
+func Load(ctx context.Context, store connectorstore.Reader) (*Graph, error) {
+	g := newGraph()
+
+	if lister, ok := store.(internalGrantLister); ok {
+		pageToken := ""
+		for {
+			resp, err := lister.ListGrantsInternal(ctx, connectorstore.GrantListOptions{
+				Mode:      connectorstore.GrantListModePayloadWithExpansion,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			for _, row := range resp.Rows {
+				if row.Grant == nil {
+					continue
+				}
+
+				expandable := expandableFromAnnotations(row.Grant)
+				if row.Expansion != nil {
+					expandable = &v2.GrantExpandable{
+						EntitlementIds:  row.Expansion.SourceEntitlementIDs,
+						Shallow:         row.Expansion.Shallow,
+						ResourceTypeIds: row.Expansion.ResourceTypeIDs,
+					}
+				}
+
+				g.addGrant(row.Grant, expandable)
+			}
+
+			if resp.NextPageToken == "" {
+				break
+			}
+			pageToken = resp.NextPageToken
+		}
+
+		return g, nil
+	}
+
+	pageToken := ""
+	for {
+		resp, err := store.ListGrants(ctx, &v2.GrantsServiceListGrantsRequest{PageToken: pageToken})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, grant := range resp.List {
+			g.addGrant(grant, expandableFromAnnotations(grant))
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	return g, nil
+}
+
+func expandableFromAnnotations(grant *v2.Grant) *v2.GrantExpandable {
+	expandable := &v2.GrantExpandable{}
+	annos := annotations.Annotations(grant.GetAnnotations())
+	ok, err := annos.Pick(expandable)
+	if err != nil || !ok {
+		return nil
+	}
+
+	return expandable
+}
+
+func (g *Graph) addGrant(grant *v2.Grant, expandable *v2.GrantExpandable) {
+	if grant.GetEntitlement() == nil || grant.GetPrincipal().GetId() == nil {
+		return
+	}
+
+	enID := grant.Entitlement.Id
+	principalKey := ResourceKey(grant.Principal.Id)
+
+	// Keep the sources recorded by a previous expansion so paths can still be traced when the
+	// annotations that produced them are no longer available.
+	if len(grant.GetSources().GetSources()) > 0 {
+		g.sourcedGrants[sourcedGrantKey(principalKey, enID)] = grant
+	}
+
+	if !IsDirect(grant) {
+		return
+	}
+
+	if _, ok := g.entitlements[enID]; !ok {
+		g.entitlements[enID] = grant.Entitlement
+		if rID := grant.Entitlement.GetResource().GetId(); rID != nil {
+			rKey := ResourceKey(rID)
+			g.resources[rKey] = append(g.resources[rKey], enID)
+		}
+	}
+	g.principals[principalKey] = grant.Principal.Id
+	g.grantsByEntitlement[enID] = append(g.grantsByEntitlement[enID], grant)
+	g.grantsByPrincipal[principalKey] = append(g.grantsByPrincipal[principalKey], grant)
+
+	if expandable == nil {
+		return
+	}
+
+	for _, sourceID := range expandable.GetEntitlementIds() {
+		if sourceID == "" || sourceID == enID {
+			continue
+		}
+
+		e := &Edge{
+			GrantID:             grant.Id,
+			SourceEntitlementID: sourceID,
+			TargetEntitlementID: enID,
+			Principal:           grant.Principal.Id,
+			Shallow:             expandable.GetShallow(),
+			ResourceTypeIDs:     expandable.GetResourceTypeIds(),
+		}
+		g.edgesBySource[sourceID] = append(g.edgesBySource[sourceID], e)
+		g.edgesByTarget[enID] = append(g.edgesByTarget[enID], e)
+	}
+}
+
+// Entitlement returns the entitlement as it was recorded on its grants, or nil if nothing grants it.
+func (g *Graph) Entitlement(id string) *v2.Entitlement {
+	return g.entitlements[id]
+}
+
+// EntitlementsForResource returns the IDs of the granted entitlements on a resource.
+func (g *Graph) EntitlementsForResource(resource *v2.ResourceId) []string {
+	return g.resources[ResourceKey(resource)]
+}
+
+// Principals returns every principal with at least one direct grant, sorted by key.
+func (g *Graph) Principals() []*v2.ResourceId {
+	keys := make([]string, 0, len(g.principals))
+	for k := range g.principals {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	ret := make([]*v2.ResourceId, 0, len(keys))
+	for _, k := range keys {
+		ret = append(ret, g.principals[k])
+	}
+
+	return ret
+}
+
+// DirectGrants returns the direct grants held by a principal.
+func (g *Graph) DirectGrants(principal *v2.ResourceId) []*v2.Grant {
+	return g.grantsByPrincipal[ResourceKey(principal)]
+}
+
+// DirectGrantsForEntitlement returns the direct grants for an entitlement.
+func (g *Graph) DirectGrantsForEntitlement(entitlementID string) []*v2.Grant {
+	return g.grantsByEntitlement[entitlementID]
+}
+
+// EdgesFrom returns the edges that pass an entitlement on to other entitlements.
+func (g *Graph) EdgesFrom(entitlementID string) []*Edge {
+	return g.edgesBySource[entitlementID]
+}
+
+// EdgesTo returns the edges that pass other entitlements on to an entitlement.
+func (g *Graph) EdgesTo(entitlementID string) []*Edge {
+	return g.edgesByTarget[entitlementID]
+}
+
+// Edges returns every expansion edge, ordered by target entitlement ID and then by the order the grants were read.
+func (g *Graph) Edges() []*Edge {
+	targets := make([]string, 0, len(g.edgesByTarget))
+	for k := range g.edgesByTarget {
+		targets = append(targets, k)
+	}
+	sort.Strings(targets)
+
+	var ret []*Edge
+	for _, k := range targets {
+		ret = append(ret, g.edgesByTarget[k]...)
+	}
+
+	return ret
+}
+
+// Applies reports whether the edge passes its target entitlement on to a principal who holds the source
+// entitlement. Shallow edges only apply to direct holders, and edges restricted to resource types only apply
+// to principals of those types.
+func (e *Edge) Applies(principal *v2.ResourceId, direct bool) bool {
+	if e.Shallow && !direct {
+		return false
+	}
+
+	if len(e.ResourceTypeIDs) > 0 && !slices.Contains(e.ResourceTypeIDs, principal.ResourceType) {
+		return false
+	}
+
+	return true
+}
+
+// EffectiveAccess returns every entitlement the principal holds, directly or through expansion, ordered by depth
+// and then entitlement ID. Cycles between entitlements are followed once.
+func (g *Graph) EffectiveAccess(principal *v2.ResourceId) []*Access {
+	key := ResourceKey(principal)
+	if cached, ok := g.accessCache[key]; ok {
+		return cached
+	}
+
+	held := make(map[string]*Access)
+	var queue []*Access
+
+	for _, grant := range g.grantsByPrincipal[key] {
+		if a, ok := held[grant.Entitlement.Id]; ok {
+			if a.Grant == nil {
+				a.Grant = grant
+			}
+			continue
+		}
+
+		a := &Access{
+			EntitlementID: grant.Entitlement.Id,
+			Direct:        true,
+			Grant:         grant,
+		}
+		held[a.EntitlementID] = a
+		queue = append(queue, a)
+	}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, e := range g.edgesBySource[current.EntitlementID] {
+			if !e.Applies(principal, current.Direct) {
+				continue
+			}
+
+			if a, ok := held[e.TargetEntitlementID]; ok {
+				a.Via = append(a.Via, e)
+				continue
+			}
+
+			a := &Access{
+				EntitlementID: e.TargetEntitlementID,
+				Via:           []*Edge{e},
+				Depth:         current.Depth + 1,
+			}
+			held[a.EntitlementID] = a
+			queue = append(queue, a)
+		}
+	}
+
+	ret := make([]*Access, 0, len(held))
+	for _, a := range held {
+		ret = append(ret, a)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Depth != ret[j].Depth {
+			return ret[i].Depth < ret[j].Depth
+		}
+		return ret[i].EntitlementID < ret[j].EntitlementID
+	})
+
+	g.accessCache[key] = ret
+
+	return ret
+}
+
+// AccessFor returns how the principal holds the entitlement, or nil if it does not.
+func (g *Graph) AccessFor(principal *v2.ResourceId, entitlementID string) *Access {
+	for _, a := range g.EffectiveAccess(principal) {
+		if a.EntitlementID == entitlementID {
+			return a
+		}
+	}
+
+	return nil
+}
+
+// EffectiveHolders returns every principal that holds the entitlement, directly or through expansion, sorted by
+// principal key.
+func (g *Graph) EffectiveHolders(entitlementID string) []*Holder {
+	// Walk the edges backwards to find every entitlement whose holders could end up with this one.
+	sources := map[string]struct{}{entitlementID: {}}
+	queue := []string{entitlementID}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, e := range g.edgesByTarget[current] {
+			if _, ok := sources[e.SourceEntitlementID]; ok {
+				continue
+			}
+			sources[e.SourceEntitlementID] = struct{}{}
+			queue = append(queue, e.SourceEntitlementID)
+		}
+	}
+
+	candidates := make(map[string]*v2.ResourceId)
+	for sourceID := range sources {
+		for _, grant := range g.grantsByEntitlement[sourceID] {
+			candidates[ResourceKey(grant.Principal.Id)] = grant.Principal.Id
+		}
+	}
+
+	keys := make([]string, 0, len(candidates))
+	for k := range candidates {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var ret []*Holder
+	for _, k := range keys {
+		principal := candidates[k]
+		if a := g.AccessFor(principal, entitlementID); a != nil {
+			ret = append(ret, &Holder{Principal: principal, Access: a})
+		}
+	}
+
+	return ret
+}
+
+// Hop is one step on a path from a principal to an entitlement.
+type Hop struct {
+	EntitlementID string
+	// Direct is set on the first hop of a path, where the principal holds the entitlement through its own grant.
+	Direct bool
+	// Grant is the principal's direct grant for a direct hop, or the expanded grant whose sources recorded the hop.
+	Grant *v2.Grant
+	// Edge is the expansion edge that passed the entitlement on, if the hop came from a GrantExpandable annotation.
+	Edge *Edge
+}
+
+// Path is a chain of hops that starts with an entitlement the principal holds directly.
+type Path []*Hop
+
+// Paths returns the paths through which a principal holds an entitlement. Paths are traced through expansion edges
+// and through the sources recorded on previously expanded grants. Entitlements are not revisited within a path, so
+// cycles terminate. If limit is greater than zero, at most limit paths are returned.
+func (g *Graph) Paths(principal *v2.ResourceId, entitlementID string, limit int) []Path {
+	principalKey := ResourceKey(principal)
+
+	direct := make(map[string]*v2.Grant)
+	for _, grant := range g.grantsByPrincipal[principalKey] {
+		if _, ok := direct[grant.Entitlement.Id]; !ok {
+			direct[grant.Entitlement.Id] = grant
+		}
+	}
+
+	var paths []Path
+	full := func() bool {
+		return limit > 0 && len(paths) >= limit
+	}
+	addPath := func(start *Hop, rest []*Hop) {
+		if full() {
+			return
+		}
+		p := make(Path, 0, len(rest)+1)
+		p = append(p, start)
+		p = append(p, rest...)
+		paths = append(paths, p)
+	}
+	prepend := func(hop *Hop, rest []*Hop) []*Hop {
+		return append([]*Hop{hop}, rest...)
+	}
+
+	onPath := make(map[string]bool)
+	var walk func(enID string, rest []*Hop)
+	walk = func(enID string, rest []*Hop) {
+		if full() {
+			return
+		}
+
+		if grant, ok := direct[enID]; ok {
+			addPath(&Hop{EntitlementID: enID, Direct: true, Grant: grant}, rest)
+		}
+
+		onPath[enID] = true
+		defer delete(onPath, enID)
+
+		walked := make(map[string]bool)
+		for _, e := range g.edgesByTarget[enID] {
+			if onPath[e.SourceEntitlementID] || !e.Applies(principal, true) {
+				continue
+			}
+			walked[e.SourceEntitlementID] = true
+
+			hop := &Hop{EntitlementID: enID, Edge: e}
+			if e.Shallow {
+				// Shallow edges only pass on to direct holders, so the path has to start at the source.
+				if grant, ok := direct[e.SourceEntitlementID]; ok {
+					addPath(&Hop{EntitlementID: e.SourceEntitlementID, Direct: true, Grant: grant}, prepend(hop, rest))
+				}
+				continue
+			}
+
+			walk(e.SourceEntitlementID, prepend(hop, rest))
+		}
+
+		sourced, ok := g.sourcedGrants[sourcedGrantKey(principalKey, enID)]
+		if !ok {
+			return
+		}
+
+		sourceIDs := make([]string, 0, len(sourced.Sources.Sources))
+		for sourceID := range sourced.Sources.Sources {
+			sourceIDs = append(sourceIDs, sourceID)
+		}
+		sort.Strings(sourceIDs)
+
+		for _, sourceID := range sourceIDs {
+			if sourceID == enID || onPath[sourceID] || walked[sourceID] {
+				continue
+			}
+
+			walk(sourceID, prepend(&Hop{EntitlementID: enID, Grant: sourced}, rest))
+		}
+	}
+
+	walk(entitlementID, nil)
+
+	return paths
+}
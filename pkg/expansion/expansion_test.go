@@ -0,0 +1,403 @@
+package expansion
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
+)
+
+func testID(key string) *v2.ResourceId {
+	rt, id, _ := strings.Cut(key, ":")
+	return &v2.ResourceId{ResourceType: rt, Resource: id}
+}
+
+func testEntitlement(id string) *v2.Entitlement {
+	resource := id[:strings.LastIndex(id, ":")]
+	return &v2.Entitlement{Id: id, Resource: &v2.Resource{Id: testID(resource)}}
+}
+
+// testGrant is a direct grant of an entitlement to a principal. Sources mark the grant as the result of a previous
+// expansion.
+type testGrant struct {
+	entitlement string
+	principal   string
+	expandable  *v2.GrantExpandable
+	sources     []string
+}
+
+func testGraph(grants ...testGrant) *Graph {
+	g := newGraph()
+	for i, tg := range grants {
+		grant := &v2.Grant{
+			Id:          fmt.Sprintf("grant-%d", i),
+			Entitlement: testEntitlement(tg.entitlement),
+			Principal:   &v2.Resource{Id: testID(tg.principal)},
+		}
+		if len(tg.sources) > 0 {
+			grant.Sources = &v2.GrantSources{Sources: make(map[string]*v2.GrantSources_GrantSource)}
+			for _, s := range tg.sources {
+				grant.Sources.Sources[s] = &v2.GrantSources_GrantSource{}
+			}
+		}
+		g.addGrant(grant, tg.expandable)
+	}
+
+	return g
+}
+
+func expandsFrom(ids ...string) *v2.GrantExpandable {
+	return &v2.GrantExpandable{EntitlementIds: ids}
+}
+
+// accessString formats access as "<entitlement>@<depth>", with a "*" suffix for direct access.
+func accessString(access []*Access) []string {
+	var ret []string
+	for _, a := range access {
+		s := fmt.Sprintf("%s@%d", a.EntitlementID, a.Depth)
+		if a.Direct {
+			s += "*"
+		}
+		ret = append(ret, s)
+	}
+
+	return ret
+}
+
+func TestEffectiveAccess(t *testing.T) {
+	nested := []testGrant{
+		{entitlement: "group:eng:member", principal: "user:alice"},
+		{entitlement: "group:all:member", principal: "group:eng", expandable: expandsFrom("group:eng:member")},
+		{entitlement: "app:wiki:access", principal: "group:all", expandable: expandsFrom("group:all:member")},
+	}
+
+	tests := []struct {
+		name      string
+		grants    []testGrant
+		principal string
+		want      []string
+	}{
+		{
+			name:      "nested groups",
+			grants:    nested,
+			principal: "user:alice",
+			want:      []string{"group:eng:member@0*", "group:all:member@1", "app:wiki:access@2"},
+		},
+		{
+			name:      "group principal",
+			grants:    nested,
+			principal: "group:eng",
+			want:      []string{"group:all:member@0*", "app:wiki:access@1"},
+		},
+		{
+			name:      "no grants",
+			grants:    nested,
+			principal: "user:bob",
+			want:      nil,
+		},
+		{
+			name: "cycle",
+			grants: []testGrant{
+				{entitlement: "group:a:member", principal: "user:alice"},
+				{entitlement: "group:b:member", principal: "group:a", expandable: expandsFrom("group:a:member")},
+				{entitlement: "group:a:member", principal: "group:b", expandable: expandsFrom("group:b:member")},
+			},
+			principal: "user:alice",
+			want:      []string{"group:a:member@0*", "group:b:member@1"},
+		},
+		{
+			name: "shallow edge applies to direct holders",
+			grants: []testGrant{
+				{entitlement: "group:eng:member", principal: "user:alice"},
+				{entitlement: "app:wiki:access", principal: "group:eng", expandable: &v2.GrantExpandable{
+					EntitlementIds: []string{"group:eng:member"},
+					Shallow:        true,
+				}},
+			},
+			principal: "user:alice",
+			want:      []string{"group:eng:member@0*", "app:wiki:access@1"},
+		},
+		{
+			name: "shallow edge skips indirect holders",
+			grants: []testGrant{
+				{entitlement: "group:sub:member", principal: "user:alice"},
+				{entitlement: "group:eng:member", principal: "group:sub", expandable: expandsFrom("group:sub:member")},
+				{entitlement: "app:wiki:access", principal: "group:eng", expandable: &v2.GrantExpandable{
+					EntitlementIds: []string{"group:eng:member"},
+					Shallow:        true,
+				}},
+			},
+			principal: "user:alice",
+			want:      []string{"group:sub:member@0*", "group:eng:member@1"},
+		},
+		{
+			name: "resource type limited edge applies to listed types",
+			grants: []testGrant{
+				{entitlement: "group:eng:member", principal: "user:alice"},
+				{entitlement: "app:wiki:access", principal: "group:eng", expandable: &v2.GrantExpandable{
+					EntitlementIds:  []string{"group:eng:member"},
+					ResourceTypeIds: []string{"user"},
+				}},
+			},
+			principal: "user:alice",
+			want:      []string{"group:eng:member@0*", "app:wiki:access@1"},
+		},
+		{
+			name: "resource type limited edge skips other types",
+			grants: []testGrant{
+				{entitlement: "group:eng:member", principal: "group:sub"},
+				{entitlement: "app:wiki:access", principal: "group:eng", expandable: &v2.GrantExpandable{
+					EntitlementIds:  []string{"group:eng:member"},
+					ResourceTypeIds: []string{"user"},
+				}},
+			},
+			principal: "group:sub",
+			want:      []string{"group:eng:member@0*"},
+		},
+		{
+			name: "expanded grants are ignored",
+			grants: []testGrant{
+				{entitlement: "group:eng:member", principal: "user:alice"},
+				{entitlement: "app:wiki:access", principal: "user:alice", sources: []string{"group:eng:member"}},
+			},
+			principal: "user:alice",
+			want:      []string{"group:eng:member@0*"},
+		},
+		{
+			name: "grant also held directly",
+			grants: []testGrant{
+				{entitlement: "group:eng:member", principal: "user:alice"},
+				{entitlement: "app:wiki:access", principal: "group:eng", expandable: expandsFrom("group:eng:member")},
+				{
+					entitlement: "app:wiki:access",
+					principal:   "user:alice",
+					sources:     []string{"app:wiki:access", "group:eng:member"},
+				},
+			},
+			principal: "user:alice",
+			want:      []string{"app:wiki:access@0*", "group:eng:member@0*"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := testGraph(tt.grants...)
+			got := accessString(g.EffectiveAccess(testID(tt.principal)))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("EffectiveAccess() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEffectiveHolders(t *testing.T) {
+	tests := []struct {
+		name        string
+		grants      []testGrant
+		entitlement string
+		want        []string
+	}{
+		{
+			name: "nested groups",
+			grants: []testGrant{
+				{entitlement: "group:eng:member", principal: "user:alice"},
+				{entitlement: "group:all:member", principal: "group:eng", expandable: expandsFrom("group:eng:member")},
+				{entitlement: "group:all:member", principal: "user:bob"},
+				{entitlement: "app:wiki:access", principal: "group:all", expandable: expandsFrom("group:all:member")},
+			},
+			entitlement: "app:wiki:access",
+			want: []string{
+				"group:all:app:wiki:access@0*",
+				"group:eng:app:wiki:access@1",
+				"user:alice:app:wiki:access@2",
+				"user:bob:app:wiki:access@1",
+			},
+		},
+		{
+			name: "cycle",
+			grants: []testGrant{
+				{entitlement: "group:a:member", principal: "user:alice"},
+				{entitlement: "group:b:member", principal: "group:a", expandable: expandsFrom("group:a:member")},
+				{entitlement: "group:a:member", principal: "group:b", expandable: expandsFrom("group:b:member")},
+			},
+			entitlement: "group:b:member",
+			want: []string{
+				"group:a:group:b:member@0*",
+				"group:b:group:b:member@1",
+				"user:alice:group:b:member@1",
+			},
+		},
+		{
+			name: "shallow and resource type limited edges",
+			grants: []testGrant{
+				{entitlement: "group:sub:member", principal: "user:alice"},
+				{entitlement: "group:eng:member", principal: "group:sub", expandable: expandsFrom("group:sub:member")},
+				{entitlement: "group:eng:member", principal: "user:bob"},
+				{entitlement: "group:eng:member", principal: "group:ops"},
+				{entitlement: "app:wiki:access", principal: "group:eng", expandable: &v2.GrantExpandable{
+					EntitlementIds:  []string{"group:eng:member"},
+					Shallow:         true,
+					ResourceTypeIds: []string{"user"},
+				}},
+			},
+			entitlement: "app:wiki:access",
+			want: []string{
+				"group:eng:app:wiki:access@0*",
+				"user:bob:app:wiki:access@1",
+			},
+		},
+		{
+			name:        "not granted",
+			grants:      []testGrant{{entitlement: "group:eng:member", principal: "user:alice"}},
+			entitlement: "app:wiki:access",
+			want:        nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := testGraph(tt.grants...)
+			var got []string
+			for _, h := range g.EffectiveHolders(tt.entitlement) {
+				got = append(got, ResourceKey(h.Principal)+":"+accessString([]*Access{h.Access})[0])
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("EffectiveHolders() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// pathString formats a path as its entitlement IDs, with a "*" suffix on the directly held one.
+func pathString(p Path) string {
+	var hops []string
+	for _, h := range p {
+		s := h.EntitlementID
+		if h.Direct {
+			s += "*"
+		}
+		hops = append(hops, s)
+	}
+
+	return strings.Join(hops, " > ")
+}
+
+func TestPaths(t *testing.T) {
+	twoRoutes := []testGrant{
+		{entitlement: "group:eng:member", principal: "user:alice"},
+		{entitlement: "group:ops:member", principal: "user:alice"},
+		{entitlement: "app:wiki:access", principal: "group:eng", expandable: expandsFrom("group:eng:member")},
+		{entitlement: "app:wiki:access", principal: "group:ops", expandable: expandsFrom("group:ops:member")},
+	}
+
+	tests := []struct {
+		name        string
+		grants      []testGrant
+		principal   string
+		entitlement string
+		limit       int
+		want        []string
+	}{
+		{
+			name:        "every route",
+			grants:      twoRoutes,
+			principal:   "user:alice",
+			entitlement: "app:wiki:access",
+			want: []string{
+				"group:eng:member* > app:wiki:access",
+				"group:ops:member* > app:wiki:access",
+			},
+		},
+		{
+			name:        "limit",
+			grants:      twoRoutes,
+			principal:   "user:alice",
+			entitlement: "app:wiki:access",
+			limit:       1,
+			want:        []string{"group:eng:member* > app:wiki:access"},
+		},
+		{
+			name: "direct and inherited",
+			grants: append([]testGrant{
+				{entitlement: "app:wiki:access", principal: "user:alice"},
+			}, twoRoutes[0], twoRoutes[2]),
+			principal:   "user:alice",
+			entitlement: "app:wiki:access",
+			want: []string{
+				"app:wiki:access*",
+				"group:eng:member* > app:wiki:access",
+			},
+		},
+		{
+			name: "cycle",
+			grants: []testGrant{
+				{entitlement: "group:a:member", principal: "user:alice"},
+				{entitlement: "group:b:member", principal: "group:a", expandable: expandsFrom("group:a:member")},
+				{entitlement: "group:a:member", principal: "group:b", expandable: expandsFrom("group:b:member")},
+			},
+			principal:   "user:alice",
+			entitlement: "group:b:member",
+			want:        []string{"group:a:member* > group:b:member"},
+		},
+		{
+			name: "shallow edge needs a direct source",
+			grants: []testGrant{
+				{entitlement: "group:sub:member", principal: "user:alice"},
+				{entitlement: "group:eng:member", principal: "group:sub", expandable: expandsFrom("group:sub:member")},
+				{entitlement: "app:wiki:access", principal: "group:eng", expandable: &v2.GrantExpandable{
+					EntitlementIds: []string{"group:eng:member"},
+					Shallow:        true,
+				}},
+			},
+			principal:   "user:alice",
+			entitlement: "app:wiki:access",
+			want:        nil,
+		},
+		{
+			name: "recorded sources",
+			grants: []testGrant{
+				{entitlement: "group:eng:member", principal: "user:alice"},
+				{entitlement: "app:wiki:access", principal: "user:alice", sources: []string{"group:eng:member"}},
+			},
+			principal:   "user:alice",
+			entitlement: "app:wiki:access",
+			want:        []string{"group:eng:member* > app:wiki:access"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := testGraph(tt.grants...)
+			var got []string
+			for _, p := range g.Paths(testID(tt.principal), tt.entitlement, tt.limit) {
+				got = append(got, pathString(p))
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Paths() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEdges(t *testing.T) {
+	g := testGraph(
+		testGrant{entitlement: "group:eng:member", principal: "user:alice"},
+		testGrant{entitlement: "group:all:member", principal: "group:eng", expandable: expandsFrom("group:eng:member")},
+		testGrant{
+			entitlement: "app:wiki:access",
+			principal:   "group:all",
+			expandable:  expandsFrom("group:all:member", "group:all:admin"),
+		},
+	)
+
+	var got []string
+	for _, e := range g.Edges() {
+		got = append(got, fmt.Sprintf("%s>%s", e.SourceEntitlementID, e.TargetEntitlementID))
+	}
+	want := []string{"group:all:member>app:wiki:access", "group:all:admin>app:wiki:access", "group:eng:member>group:all:member"}
+	if !slices.Equal(got, want) {
+		t.Errorf("Edges() = %v, want %v", got, want)
+	}
+}
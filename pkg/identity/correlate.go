@@ -0,0 +1,275 @@
+package identity
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MatchOverride is recorded as the match key of accounts joined by an override.
+const MatchOverride = "override"
+
+// Overrides is the layout of an override mapping file, which joins accounts that the match keys cannot.
+//
+//	people:
+//	  - key: alice@example.com
+//	    accounts:
+//	      - file: github*.c1z
+//	        account: user:alice-gh
+//	      - file: aws.c1z
+//	        account: iam_user:AIDAEXAMPLE
+type Overrides struct {
+	People []*OverridePerson `yaml:"people"`
+}
+
+type OverridePerson struct {
+	Key      string             `yaml:"key"`
+	Accounts []*OverrideAccount `yaml:"accounts"`
+}
+
+// OverrideAccount selects an account by its resource type and ID. File optionally limits it to c1z files whose base
+// name matches the pattern.
+type OverrideAccount struct {
+	File    string `yaml:"file"`
+	Account string `yaml:"account"`
+}
+
+// LoadOverrides reads an override mapping file.
+func LoadOverrides(overridesPath string) (*Overrides, error) {
+	data, err := os.ReadFile(overridesPath)
+	if err != nil {
+		return nil, err
+	}
+
+	overrides := &Overrides{}
+	err = yaml.Unmarshal(data, overrides)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", overridesPath, err)
+	}
+
+	for i, p := range overrides.People {
+		if p.Key == "" {
+			return nil, fmt.Errorf("person %d is missing a key", i+1)
+		}
+		for _, a := range p.Accounts {
+			if _, _, ok := strings.Cut(a.Account, ":"); !ok {
+				return nil, fmt.Errorf("account %q of %s must be in the form resource_type:resource_id", a.Account, p.Key)
+			}
+			if _, err := path.Match(a.File, ""); err != nil {
+				return nil, fmt.Errorf("person %s has an invalid file pattern %q: %w", p.Key, a.File, err)
+			}
+		}
+	}
+
+	return overrides, nil
+}
+
+// Matches reports whether the override selects the account.
+func (o *OverrideAccount) Matches(a *Account) bool {
+	if o.File != "" {
+		if ok, _ := path.Match(o.File, filepath.Base(a.Source)); !ok {
+			return false
+		}
+	}
+
+	resourceType, resourceID, _ := strings.Cut(o.Account, ":")
+	return a.Resource.Id.ResourceType == resourceType && a.Resource.Id.Resource == resourceID
+}
+
+// Options configures how accounts are correlated into identities.
+type Options struct {
+	// Keys are the match keys to join accounts on, in order of precedence. Accounts that both have values for a key
+	// are never joined on a later key unless they share one of those values.
+	Keys []string
+	// Overrides join accounts regardless of their match keys.
+	Overrides *Overrides
+}
+
+// Correlate groups accounts that share an email address. Accounts without an email address become identities of
+// their own. Identities are returned sorted by key.
+func Correlate(accounts []*Account) []*Identity {
+	return CorrelateWith(accounts, Options{Keys: []string{KeyEmail}})
+}
+
+// correlation is a union-find over accounts that tracks each set's values for every match key.
+type correlation struct {
+	accounts  []*Account
+	keys      []string
+	parent    []int
+	values    []map[string]map[string]struct{}
+	pinned    []string
+	matchedBy map[int]string
+}
+
+func (c *correlation) find(i int) int {
+	if c.parent[i] != i {
+		c.parent[i] = c.find(c.parent[i])
+	}
+	return c.parent[i]
+}
+
+// conflicts reports whether the sets have different values for a match key with a higher precedence than level.
+func (c *correlation) conflicts(ra int, rb int, level int) bool {
+	if c.pinned[ra] != "" && c.pinned[rb] != "" && c.pinned[ra] != c.pinned[rb] {
+		return true
+	}
+
+	for _, k := range c.keys[:level] {
+		va, vb := c.values[ra][k], c.values[rb][k]
+		if len(va) == 0 || len(vb) == 0 {
+			continue
+		}
+
+		shared := false
+		for v := range va {
+			if _, ok := vb[v]; ok {
+				shared = true
+				break
+			}
+		}
+		if !shared {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *correlation) union(a int, b int, matchKey string, level int) {
+	ra, rb := c.find(a), c.find(b)
+	if ra == rb {
+		return
+	}
+	if level >= 0 && c.conflicts(ra, rb, level) {
+		return
+	}
+
+	c.parent[rb] = ra
+	for k, values := range c.values[rb] {
+		if c.values[ra][k] == nil {
+			c.values[ra][k] = make(map[string]struct{})
+		}
+		for v := range values {
+			c.values[ra][k][v] = struct{}{}
+		}
+	}
+	if c.pinned[ra] == "" {
+		c.pinned[ra] = c.pinned[rb]
+	}
+
+	for _, i := range []int{a, b} {
+		if _, ok := c.matchedBy[i]; !ok {
+			c.matchedBy[i] = matchKey
+		}
+	}
+}
+
+// CorrelateWith groups accounts into identities using the options. Identities are keyed by their override key, their
+// first email address or, failing both, the key of their first account, and are returned sorted by key.
+func CorrelateWith(accounts []*Account, opts Options) []*Identity {
+	c := &correlation{
+		accounts:  accounts,
+		keys:      opts.Keys,
+		parent:    make([]int, len(accounts)),
+		values:    make([]map[string]map[string]struct{}, len(accounts)),
+		pinned:    make([]string, len(accounts)),
+		matchedBy: make(map[int]string),
+	}
+	for i, a := range accounts {
+		c.parent[i] = i
+		c.values[i] = make(map[string]map[string]struct{})
+		for _, k := range opts.Keys {
+			for _, v := range a.Values(k) {
+				if c.values[i][k] == nil {
+					c.values[i][k] = make(map[string]struct{})
+				}
+				c.values[i][k][v] = struct{}{}
+			}
+		}
+	}
+
+	if opts.Overrides != nil {
+		for _, p := range opts.Overrides.People {
+			first := -1
+			for i, a := range accounts {
+				for _, o := range p.Accounts {
+					if !o.Matches(a) {
+						continue
+					}
+
+					if first == -1 {
+						first = i
+						c.pinned[c.find(i)] = p.Key
+					} else {
+						c.union(first, i, MatchOverride, -1)
+					}
+					break
+				}
+			}
+		}
+	}
+
+	for level, k := range opts.Keys {
+		byValue := make(map[string]int)
+		for i, a := range accounts {
+			for _, v := range a.Values(k) {
+				if j, ok := byValue[v]; ok {
+					c.union(j, i, k, level)
+					continue
+				}
+				byValue[v] = i
+			}
+		}
+	}
+
+	groups := make(map[int]*Identity)
+	var ret []*Identity
+	for i, a := range accounts {
+		root := c.find(i)
+		ident, ok := groups[root]
+		if !ok {
+			ident = &Identity{
+				Key:       c.pinned[root],
+				MatchedBy: make(map[string]string),
+			}
+			groups[root] = ident
+			ret = append(ret, ident)
+		}
+		ident.Accounts = append(ident.Accounts, a)
+		if matchKey, ok := c.matchedBy[i]; ok {
+			ident.MatchedBy[a.Key()] = matchKey
+		}
+	}
+
+	for _, ident := range ret {
+		seen := make(map[string]struct{})
+		for _, a := range ident.Accounts {
+			for _, email := range a.Emails() {
+				if _, ok := seen[email]; ok {
+					continue
+				}
+				seen[email] = struct{}{}
+				ident.Emails = append(ident.Emails, email)
+			}
+		}
+
+		switch {
+		case ident.Key != "":
+		case len(ident.Emails) > 0:
+			ident.Key = ident.Emails[0]
+		default:
+			ident.Key = ident.Accounts[0].Key()
+		}
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Key < ret[j].Key
+	})
+
+	return ret
+}
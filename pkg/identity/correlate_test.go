@@ -0,0 +1,256 @@
+package identity
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// identityStrings formats identities as "<key>: <account>(<matched by>) ...", where accounts are identified by their
+// resource ID.
+func identityStrings(identities []*Identity) []string {
+	var ret []string
+	for _, ident := range identities {
+		var accounts []string
+		for _, a := range ident.Accounts {
+			s := a.Resource.Id.Resource
+			if matchedBy, ok := ident.MatchedBy[a.Key()]; ok {
+				s += fmt.Sprintf("(%s)", matchedBy)
+			}
+			accounts = append(accounts, s)
+		}
+		ret = append(ret, fmt.Sprintf("%s: %s", ident.Key, strings.Join(accounts, " ")))
+	}
+
+	return ret
+}
+
+func TestCorrelate(t *testing.T) {
+	accounts := []*Account{
+		testAccount(t, testUser{source: "okta.c1z", id: "user:alice", emails: []string{"Alice@example.com"}}),
+		testAccount(t, testUser{source: "github.c1z", id: "user:alice-gh", emails: []string{"alice@example.com"}}),
+		testAccount(t, testUser{source: "github.c1z", id: "user:bot", login: "bot"}),
+		testAccount(t, testUser{source: "okta.c1z", id: "user:bob", emails: []string{"bob@example.com"}}),
+	}
+
+	want := []string{
+		"alice@example.com: alice(email) alice-gh(email)",
+		"bob@example.com: bob",
+		"github.c1z|user:bot: bot",
+	}
+	if got := identityStrings(Correlate(accounts)); !slices.Equal(got, want) {
+		t.Errorf("Correlate() = %v, want %v", got, want)
+	}
+}
+
+func TestCorrelateWithKeyPrecedence(t *testing.T) {
+	tests := []struct {
+		name     string
+		keys     []string
+		accounts []testUser
+		want     []string
+	}{
+		{
+			name: "later key does not join accounts with different values for an earlier key",
+			keys: []string{KeyEmail, KeyLogin},
+			accounts: []testUser{
+				{id: "user:1", emails: []string{"a@example.com"}, login: "sam"},
+				{id: "user:2", emails: []string{"b@example.com"}, login: "sam"},
+			},
+			want: []string{"a@example.com: 1", "b@example.com: 2"},
+		},
+		{
+			name: "later key joins accounts without a value for an earlier key",
+			keys: []string{KeyEmail, KeyLogin},
+			accounts: []testUser{
+				{id: "user:1", emails: []string{"a@example.com"}, login: "sam"},
+				{id: "user:2", login: "Sam"},
+			},
+			want: []string{"a@example.com: 1(login) 2(login)"},
+		},
+		{
+			name: "earlier key joins accounts with different values for a later key",
+			keys: []string{KeyEmail, KeyLogin},
+			accounts: []testUser{
+				{id: "user:1", emails: []string{"a@example.com"}, login: "sam"},
+				{id: "user:2", emails: []string{"a@example.com"}, login: "samuel"},
+			},
+			want: []string{"a@example.com: 1(email) 2(email)"},
+		},
+		{
+			name: "key order decides precedence",
+			keys: []string{KeyLogin, KeyEmail},
+			accounts: []testUser{
+				{id: "user:1", emails: []string{"a@example.com"}, login: "sam"},
+				{id: "user:2", emails: []string{"b@example.com"}, login: "sam"},
+			},
+			want: []string{"a@example.com: 1(login) 2(login)"},
+		},
+		{
+			name: "later key does not join a set that has a different value for an earlier key",
+			keys: []string{KeyEmail, KeyEmployeeID},
+			accounts: []testUser{
+				{id: "user:1", emails: []string{"a@example.com"}, employeeIDs: []string{"100"}},
+				{id: "user:2", employeeIDs: []string{"100"}},
+				{id: "user:3", emails: []string{"b@example.com"}, employeeIDs: []string{"100"}},
+			},
+			want: []string{"a@example.com: 1(employee_id) 2(employee_id)", "b@example.com: 3"},
+		},
+		{
+			name: "shared value among several",
+			keys: []string{KeyEmail, KeyLogin},
+			accounts: []testUser{
+				{id: "user:1", emails: []string{"a@example.com", "sam@example.com"}, login: "sam"},
+				{id: "user:2", emails: []string{"sam@example.com"}, login: "samuel"},
+				{id: "user:3", emails: []string{"a@example.com"}, login: "sam"},
+			},
+			want: []string{"a@example.com: 1(email) 2(email) 3(email)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var accounts []*Account
+			for _, u := range tt.accounts {
+				accounts = append(accounts, testAccount(t, u))
+			}
+
+			got := identityStrings(CorrelateWith(accounts, Options{Keys: tt.keys}))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("CorrelateWith() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCorrelateWithOverrides(t *testing.T) {
+	accounts := []*Account{
+		testAccount(t, testUser{source: "/data/github-prod.c1z", id: "user:alice-gh", login: "alice-gh"}),
+		testAccount(t, testUser{source: "/data/gitlab.c1z", id: "user:alice-gh", emails: []string{"alice-gl@example.com"}}),
+		testAccount(t, testUser{source: "/data/aws.c1z", id: "iam_user:AIDA1", emails: []string{"alice@example.com"}}),
+		testAccount(t, testUser{source: "/data/okta.c1z", id: "user:alice", emails: []string{"alice@example.com"}}),
+		testAccount(t, testUser{source: "/data/okta.c1z", id: "user:bob", emails: []string{"bob@example.com"}}),
+		testAccount(t, testUser{source: "/data/okta.c1z", id: "user:robert", emails: []string{"bob@example.com"}}),
+	}
+
+	overrides := &Overrides{People: []*OverridePerson{
+		{
+			Key: "alice",
+			Accounts: []*OverrideAccount{
+				{File: "github*.c1z", Account: "user:alice-gh"},
+				{Account: "iam_user:AIDA1"},
+			},
+		},
+		{Key: "bob", Accounts: []*OverrideAccount{{Account: "user:bob"}}},
+		{Key: "robert", Accounts: []*OverrideAccount{{Account: "user:robert"}}},
+	}}
+
+	want := []string{
+		"alice: alice-gh(override) AIDA1(override) alice(email)",
+		"alice-gl@example.com: alice-gh",
+		"bob: bob",
+		"robert: robert",
+	}
+	got := identityStrings(CorrelateWith(accounts, Options{Keys: []string{KeyEmail}, Overrides: overrides}))
+	if !slices.Equal(got, want) {
+		t.Errorf("CorrelateWith() = %v, want %v", got, want)
+	}
+}
+
+func TestOverrideAccountMatches(t *testing.T) {
+	a := testAccount(t, testUser{source: "/data/github-prod.c1z", id: "user:alice"})
+
+	tests := []struct {
+		name     string
+		override OverrideAccount
+		want     bool
+	}{
+		{name: "any file", override: OverrideAccount{Account: "user:alice"}, want: true},
+		{name: "file pattern", override: OverrideAccount{File: "github*.c1z", Account: "user:alice"}, want: true},
+		{name: "other file", override: OverrideAccount{File: "gitlab*.c1z", Account: "user:alice"}, want: false},
+		{name: "full path is not matched", override: OverrideAccount{File: "/data/*.c1z", Account: "user:alice"}, want: false},
+		{name: "other resource type", override: OverrideAccount{Account: "member:alice"}, want: false},
+		{name: "other resource", override: OverrideAccount{Account: "user:bob"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.override.Matches(a); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadOverrides(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{
+			name: "valid",
+			data: `people:
+  - key: alice@example.com
+    accounts:
+      - file: github*.c1z
+        account: user:alice-gh
+      - account: iam_user:AIDA1
+`,
+		},
+		{
+			name:    "missing key",
+			data:    "people:\n  - accounts:\n      - account: user:alice\n",
+			wantErr: "person 1 is missing a key",
+		},
+		{
+			name:    "account without a resource type",
+			data:    "people:\n  - key: alice\n    accounts:\n      - account: alice\n",
+			wantErr: "must be in the form resource_type:resource_id",
+		},
+		{
+			name:    "invalid file pattern",
+			data:    "people:\n  - key: alice\n    accounts:\n      - file: \"[\"\n        account: user:alice\n",
+			wantErr: "invalid file pattern",
+		},
+		{
+			name:    "invalid yaml",
+			data:    "people: {",
+			wantErr: "error parsing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := filepath.Join(t.TempDir(), "overrides.yaml")
+			if err := os.WriteFile(p, []byte(tt.data), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			overrides, err := LoadOverrides(p)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("LoadOverrides() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadOverrides() error = %v", err)
+			}
+
+			if len(overrides.People) != 1 || len(overrides.People[0].Accounts) != 2 {
+				t.Fatalf("LoadOverrides() = %+v, want one person with two accounts", overrides.People)
+			}
+			if a := overrides.People[0].Accounts[0]; a.File != "github*.c1z" || a.Account != "user:alice-gh" {
+				t.Errorf("first account = %+v", a)
+			}
+		})
+	}
+
+	if _, err := LoadOverrides(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Error("LoadOverrides() of a missing file should fail")
+	}
+}
@@ -0,0 +1,302 @@
+package identity
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
+	"github.com/conductorone/baton-sdk/pkg/annotations"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Match keys select which account attributes are compared when matching accounts across sources.
+const (
+	KeyEmail      = "email"
+	KeyLogin      = "login"
+	KeyEmployeeID = "employee_id"
+	// KeyAlias compares logins and login aliases against each other.
+	KeyAlias = "alias"
+	// KeyResourceID compares resource types and IDs, which only match between syncs of the same connector.
+	KeyResourceID = "resource_id"
+	// KeyDisplayName compares display names, ignoring case and repeated whitespace.
+	KeyDisplayName = "display_name"
+	// KeyProfilePrefix followed by a field name compares the value of that field in the user profile.
+	KeyProfilePrefix = "profile:"
+)
+
+// MatchKeys lists the match keys of the user trait in the default order of precedence.
+var MatchKeys = []string{KeyEmail, KeyLogin, KeyEmployeeID, KeyAlias}
+
+// SupportedMatchKeys describes every supported match key, for use in help text and errors.
+var SupportedMatchKeys = strings.Join(append(slices.Clone(MatchKeys), KeyResourceID, KeyDisplayName, KeyProfilePrefix+"<field>"), ", ")
+
+// ValidateMatchKeys returns an error if any of the keys is not supported.
+func ValidateMatchKeys(keys []string) error {
+	if len(keys) == 0 {
+		return fmt.Errorf("at least one match key is required")
+	}
+
+	for _, k := range keys {
+		if field, ok := strings.CutPrefix(k, KeyProfilePrefix); ok {
+			if field == "" {
+				return fmt.Errorf("match key %q is missing a profile field name", k)
+			}
+			continue
+		}
+		if !slices.Contains(MatchKeys, k) && k != KeyResourceID && k != KeyDisplayName {
+			return fmt.Errorf("unsupported match key %q, must be one of %s", k, SupportedMatchKeys)
+		}
+	}
+
+	return nil
+}
+
+// Account is a principal from a single c1z file.
+type Account struct {
+	Source   string
+	Resource *v2.Resource
+	User     *v2.UserTrait
+}
+
+// NewAccount wraps a principal resource from the given source, picking its UserTrait if it has one.
+func NewAccount(source string, r *v2.Resource) (*Account, error) {
+	a := &Account{
+		Source:   source,
+		Resource: r,
+	}
+
+	ut := &v2.UserTrait{}
+	annos := annotations.Annotations(r.Annotations)
+	ok, err := annos.Pick(ut)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		a.User = ut
+	}
+
+	return a, nil
+}
+
+// Key returns a key that is unique to the account across every source.
+func (a *Account) Key() string {
+	return fmt.Sprintf("%s|%s:%s", a.Source, a.Resource.Id.ResourceType, a.Resource.Id.Resource)
+}
+
+// Emails returns the account's email addresses, lowercased, with the primary address first.
+func (a *Account) Emails() []string {
+	if a.User == nil {
+		return nil
+	}
+
+	var ret []string
+	for _, e := range a.User.Emails {
+		addr := normalize(e.Address)
+		if addr == "" {
+			continue
+		}
+		if e.IsPrimary {
+			ret = append([]string{addr}, ret...)
+		} else {
+			ret = append(ret, addr)
+		}
+	}
+
+	return ret
+}
+
+// PrimaryEmail returns the account's primary email address, falling back to the first address it has.
+func (a *Account) PrimaryEmail() string {
+	emails := a.Emails()
+	if len(emails) == 0 {
+		return ""
+	}
+
+	return emails[0]
+}
+
+// Logins returns the account's login, lowercased.
+func (a *Account) Logins() []string {
+	if a.User == nil {
+		return nil
+	}
+
+	login := normalize(a.User.Login)
+	if login == "" {
+		return nil
+	}
+
+	return []string{login}
+}
+
+// Aliases returns the account's login and login aliases, lowercased.
+func (a *Account) Aliases() []string {
+	if a.User == nil {
+		return nil
+	}
+
+	ret := a.Logins()
+	for _, alias := range a.User.LoginAliases {
+		alias = normalize(alias)
+		if alias == "" {
+			continue
+		}
+		ret = append(ret, alias)
+	}
+
+	return ret
+}
+
+// EmployeeIDs returns the account's employee IDs, lowercased.
+func (a *Account) EmployeeIDs() []string {
+	if a.User == nil {
+		return nil
+	}
+
+	var ret []string
+	for _, id := range a.User.EmployeeIds {
+		id = normalize(id)
+		if id == "" {
+			continue
+		}
+		ret = append(ret, id)
+	}
+
+	return ret
+}
+
+// ProfileValue returns the value of the profile field as a string, or an empty string if the account does not have it.
+// Field names are matched case-insensitively when there is no exact match.
+func (a *Account) ProfileValue(field string) string {
+	fields := a.User.GetProfile().GetFields()
+	v, ok := fields[field]
+	if !ok {
+		for k, fv := range fields {
+			if strings.EqualFold(k, field) {
+				v = fv
+				break
+			}
+		}
+	}
+
+	switch kind := v.GetKind().(type) {
+	case *structpb.Value_StringValue:
+		return strings.TrimSpace(kind.StringValue)
+	case *structpb.Value_NumberValue:
+		return strconv.FormatFloat(kind.NumberValue, 'f', -1, 64)
+	case *structpb.Value_BoolValue:
+		return strconv.FormatBool(kind.BoolValue)
+	default:
+		return ""
+	}
+}
+
+// Values returns the account's values for the given match key.
+func (a *Account) Values(key string) []string {
+	switch key {
+	case KeyEmail:
+		return a.Emails()
+	case KeyLogin:
+		return a.Logins()
+	case KeyEmployeeID:
+		return a.EmployeeIDs()
+	case KeyAlias:
+		return a.Aliases()
+	case KeyResourceID:
+		return []string{fmt.Sprintf("%s:%s", a.Resource.Id.ResourceType, a.Resource.Id.Resource)}
+	case KeyDisplayName:
+		name := strings.Join(strings.Fields(strings.ToLower(a.Resource.DisplayName)), " ")
+		if name == "" {
+			return nil
+		}
+		return []string{name}
+	}
+
+	if field, ok := strings.CutPrefix(key, KeyProfilePrefix); ok {
+		v := normalize(a.ProfileValue(field))
+		if v == "" {
+			return nil
+		}
+		return []string{v}
+	}
+
+	return nil
+}
+
+// Identity is a set of accounts, possibly from different sources, that belong to the same person.
+type Identity struct {
+	Key      string
+	Emails   []string
+	Accounts []*Account
+	// MatchedBy maps account keys to the match key, or MatchOverride, that first joined the account to another account
+	// of the identity. Accounts that are the only account of their identity are not included.
+	MatchedBy map[string]string
+}
+
+// DisplayName returns the display name of the identity's first account.
+func (i *Identity) DisplayName() string {
+	if len(i.Accounts) == 0 {
+		return i.Key
+	}
+
+	return i.Accounts[0].Resource.DisplayName
+}
+
+func normalize(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+// Index looks accounts up by their values for a set of match keys.
+type Index struct {
+	keys  []string
+	byKey map[string]map[string][]*Account
+}
+
+// NewIndex indexes the accounts by every value they have for each of the keys. Keys are tried in order when matching.
+func NewIndex(keys []string, accounts []*Account) *Index {
+	idx := &Index{
+		keys:  keys,
+		byKey: make(map[string]map[string][]*Account),
+	}
+
+	for _, k := range keys {
+		values := make(map[string][]*Account)
+		for _, a := range accounts {
+			for _, v := range a.Values(k) {
+				values[v] = append(values[v], a)
+			}
+		}
+		idx.byKey[k] = values
+	}
+
+	return idx
+}
+
+// Lookup returns the indexed accounts with the value for the key. The value is normalized before the lookup.
+func (idx *Index) Lookup(key string, value string) []*Account {
+	return idx.byKey[key][normalize(value)]
+}
+
+// Match returns the indexed accounts matching the account on the first key that matches, along with that key.
+func (idx *Index) Match(a *Account) ([]*Account, string) {
+	for _, k := range idx.keys {
+		seen := make(map[*Account]struct{})
+		var ret []*Account
+		for _, v := range a.Values(k) {
+			for _, m := range idx.byKey[k][v] {
+				if _, ok := seen[m]; ok {
+					continue
+				}
+				seen[m] = struct{}{}
+				ret = append(ret, m)
+			}
+		}
+		if len(ret) > 0 {
+			return ret, k
+		}
+	}
+
+	return nil, ""
+}
@@ -0,0 +1,195 @@
+package identity
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// testUser describes a user account. The first email address is marked as primary.
+type testUser struct {
+	source      string
+	id          string
+	name        string
+	emails      []string
+	login       string
+	aliases     []string
+	employeeIDs []string
+	profile     map[string]any
+}
+
+func testAccount(t *testing.T, u testUser) *Account {
+	t.Helper()
+
+	rt, id, _ := strings.Cut(u.id, ":")
+	ut := &v2.UserTrait{
+		Login:        u.login,
+		LoginAliases: u.aliases,
+		EmployeeIds:  u.employeeIDs,
+	}
+	for i, e := range u.emails {
+		ut.Emails = append(ut.Emails, &v2.UserTrait_Email{Address: e, IsPrimary: i == 0})
+	}
+	if u.profile != nil {
+		profile, err := structpb.NewStruct(u.profile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ut.Profile = profile
+	}
+
+	source := u.source
+	if source == "" {
+		source = "test.c1z"
+	}
+
+	return &Account{
+		Source:   source,
+		Resource: &v2.Resource{Id: &v2.ResourceId{ResourceType: rt, Resource: id}, DisplayName: u.name},
+		User:     ut,
+	}
+}
+
+func TestValidateMatchKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		keys    []string
+		wantErr string
+	}{
+		{name: "user trait keys", keys: []string{KeyEmail, KeyLogin, KeyEmployeeID, KeyAlias}},
+		{name: "other keys", keys: []string{KeyResourceID, KeyDisplayName, "profile:department"}},
+		{name: "no keys", keys: nil, wantErr: "at least one match key is required"},
+		{name: "unsupported key", keys: []string{KeyEmail, "phone"}, wantErr: `unsupported match key "phone"`},
+		{name: "missing profile field", keys: []string{"profile:"}, wantErr: "missing a profile field name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateMatchKeys(tt.keys)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateMatchKeys() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateMatchKeys() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValues(t *testing.T) {
+	a := testAccount(t, testUser{
+		id:          "user:1",
+		name:        "  Alice   Smith ",
+		emails:      []string{"Alice@Example.com", " ", "asmith@example.com"},
+		login:       " ALICE ",
+		aliases:     []string{"asmith", ""},
+		employeeIDs: []string{"E-100"},
+		profile:     map[string]any{"Department": " Engineering ", "cost_center": 4200.0, "contractor": false},
+	})
+
+	tests := []struct {
+		key  string
+		want []string
+	}{
+		{key: KeyEmail, want: []string{"alice@example.com", "asmith@example.com"}},
+		{key: KeyLogin, want: []string{"alice"}},
+		{key: KeyAlias, want: []string{"alice", "asmith"}},
+		{key: KeyEmployeeID, want: []string{"e-100"}},
+		{key: KeyResourceID, want: []string{"user:1"}},
+		{key: KeyDisplayName, want: []string{"alice smith"}},
+		{key: "profile:Department", want: []string{"engineering"}},
+		{key: "profile:department", want: []string{"engineering"}},
+		{key: "profile:cost_center", want: []string{"4200"}},
+		{key: "profile:contractor", want: []string{"false"}},
+		{key: "profile:manager", want: nil},
+		{key: "phone", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if got := a.Values(tt.key); !slices.Equal(got, tt.want) {
+				t.Errorf("Values(%q) = %v, want %v", tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmailsPrimaryFirst(t *testing.T) {
+	a := testAccount(t, testUser{id: "user:1"})
+	a.User.Emails = []*v2.UserTrait_Email{
+		{Address: "b@example.com"},
+		{Address: "A@example.com", IsPrimary: true},
+	}
+
+	want := []string{"a@example.com", "b@example.com"}
+	if got := a.Emails(); !slices.Equal(got, want) {
+		t.Errorf("Emails() = %v, want %v", got, want)
+	}
+	if got := a.PrimaryEmail(); got != "a@example.com" {
+		t.Errorf("PrimaryEmail() = %q, want %q", got, "a@example.com")
+	}
+}
+
+func TestIndexMatch(t *testing.T) {
+	accounts := []*Account{
+		testAccount(t, testUser{id: "user:1", emails: []string{"alice@example.com"}, login: "alice"}),
+		testAccount(t, testUser{id: "user:2", emails: []string{"bob@example.com"}, login: "alice"}),
+		testAccount(t, testUser{id: "user:3", login: "carol", employeeIDs: []string{"100"}}),
+	}
+	idx := NewIndex([]string{KeyEmail, KeyLogin, KeyEmployeeID}, accounts)
+
+	tests := []struct {
+		name    string
+		account *Account
+		want    []string
+		wantKey string
+	}{
+		{
+			name:    "first key that matches wins",
+			account: testAccount(t, testUser{id: "other:1", emails: []string{"ALICE@example.com"}, login: "alice"}),
+			want:    []string{"1"},
+			wantKey: KeyEmail,
+		},
+		{
+			name:    "later key",
+			account: testAccount(t, testUser{id: "other:2", emails: []string{"nobody@example.com"}, login: "Alice"}),
+			want:    []string{"1", "2"},
+			wantKey: KeyLogin,
+		},
+		{
+			name:    "last key",
+			account: testAccount(t, testUser{id: "other:3", employeeIDs: []string{"100"}}),
+			want:    []string{"3"},
+			wantKey: KeyEmployeeID,
+		},
+		{
+			name:    "no match",
+			account: testAccount(t, testUser{id: "other:4", login: "dave"}),
+			want:    nil,
+			wantKey: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, key := idx.Match(tt.account)
+			var got []string
+			for _, m := range matches {
+				got = append(got, m.Resource.Id.Resource)
+			}
+			if !slices.Equal(got, tt.want) || key != tt.wantKey {
+				t.Errorf("Match() = %v, %q, want %v, %q", got, key, tt.want, tt.wantKey)
+			}
+		})
+	}
+
+	if got := idx.Lookup(KeyLogin, " CAROL "); len(got) != 1 || got[0] != accounts[2] {
+		t.Errorf("Lookup() = %v, want the account with the login carol", got)
+	}
+}
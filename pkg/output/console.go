@@ -38,6 +38,63 @@ func (c *consoleManager) Output(ctx context.Context, out interface{}) error {
 	case *v1.SyncListOutput:
 		return c.outputSyncRuns(obj)
 
+	case *v1.CountOutput:
+		return c.outputCount(obj)
+
+	case *v1.AccessExplainOutput:
+		return c.outputAccessExplain(obj)
+
+	case *v1.WhoCanAccessOutput:
+		return c.outputWhoCanAccess(obj)
+
+	case *v1.SodCheckOutput:
+		return c.outputSodCheck(obj)
+
+	case *v1.PrivilegedReportOutput:
+		return c.outputPrivilegedReport(obj)
+
+	case *v1.DormantReportOutput:
+		return c.outputDormantReport(obj)
+
+	case *v1.AuthPostureReportOutput:
+		return c.outputAuthPostureReport(obj)
+
+	case *v1.OrphansOutput:
+		return c.outputOrphans(obj)
+
+	case *v1.LeaversOutput:
+		return c.outputLeavers(obj)
+
+	case *v1.SecretsReportOutput:
+		return c.outputSecretsReport(obj)
+
+	case *v1.ServiceAccountsReportOutput:
+		return c.outputServiceAccountsReport(obj)
+
+	case *v1.InsightsOutput:
+		return c.outputInsights(obj)
+
+	case *v1.OutliersOutput:
+		return c.outputOutliers(obj)
+
+	case *v1.RoleMiningOutput:
+		return c.outputRoleMining(obj)
+
+	case *v1.ResourceTreeOutput:
+		return c.outputResourceTree(obj)
+
+	case *v1.GroupsAnalysisOutput:
+		return c.outputGroupsAnalysis(obj)
+
+	case *v1.EntitlementUsageReportOutput:
+		return c.outputEntitlementUsageReport(obj)
+
+	case *v1.PersonOutput:
+		return c.outputPerson(obj)
+
+	case *v1.ReconcileOutput:
+		return c.outputReconcile(obj)
+
 	default:
 		return fmt.Errorf("unexpected output model")
 	}
@@ -51,6 +108,22 @@ func (c *consoleManager) formatTimestamp(ts *timestamppb.Timestamp) string {
 	return ts.AsTime().Format(time.RFC3339)
 }
 
+// outputNextPageToken writes the token to stderr so that stdout stays clean for scripts.
+func (c *consoleManager) outputNextPageToken(token string) {
+	if token == "" {
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "Next page token: %s\n", token)
+}
+
+func (c *consoleManager) outputCount(out *v1.CountOutput) error {
+	fmt.Fprintf(os.Stdout, "%d\n", out.Count)
+	c.outputNextPageToken(out.NextPageToken)
+
+	return nil
+}
+
 func (c *consoleManager) outputSyncRuns(out *v1.SyncListOutput) error {
 	syncsTable := pterm.TableData{
 		{"ID", "Started At", "Ended At", "Type", "Parent ID", "Token"},
@@ -72,6 +145,8 @@ func (c *consoleManager) outputSyncRuns(out *v1.SyncListOutput) error {
 		return err
 	}
 
+	c.outputNextPageToken(out.NextPageToken)
+
 	return nil
 }
 
@@ -98,6 +173,8 @@ func (c *consoleManager) outputResourceTypes(out *v1.ResourceTypeListOutput) err
 		return err
 	}
 
+	c.outputNextPageToken(out.NextPageToken)
+
 	return nil
 }
 
@@ -128,6 +205,8 @@ func (c *consoleManager) outputResources(out *v1.ResourceListOutput) error {
 		return err
 	}
 
+	c.outputNextPageToken(out.NextPageToken)
+
 	return nil
 }
 
@@ -150,6 +229,8 @@ func (c *consoleManager) outputEntitlements(out *v1.EntitlementListOutput) error
 		return err
 	}
 
+	c.outputNextPageToken(out.NextPageToken)
+
 	return nil
 }
 
@@ -173,6 +254,8 @@ func (c *consoleManager) outputGrants(out *v1.GrantListOutput) error {
 		return err
 	}
 
+	c.outputNextPageToken(out.NextPageToken)
+
 	return nil
 }
 
@@ -195,6 +278,13 @@ func (c *consoleManager) outputResourceAccess(out *v1.ResourceAccessListOutput)
 				pterm.LeveledListItem{Level: 2, Text: e.Slug},
 			)
 		}
+
+		for _, e := range g.InheritedEntitlements {
+			leveledList = append(
+				leveledList,
+				pterm.LeveledListItem{Level: 2, Text: fmt.Sprintf("%s (inherited)", e.Slug)},
+			)
+		}
 	}
 
 	root := putils.TreeFromLeveledList(leveledList)
@@ -206,7 +296,872 @@ func (c *consoleManager) outputResourceAccess(out *v1.ResourceAccessListOutput)
 	return nil
 }
 
+func (c *consoleManager) accessPathHopText(hop *v1.AccessPathHop) string {
+	text := hop.Entitlement.DisplayName
+	if hop.Resource != nil {
+		text = fmt.Sprintf("%s on %s (%s)", hop.Entitlement.DisplayName, hop.Resource.DisplayName, hop.ResourceType.DisplayName)
+	}
+
+	switch {
+	case hop.Direct:
+		return fmt.Sprintf("%s [direct]", text)
+	case hop.Via != nil && hop.Shallow:
+		return fmt.Sprintf("%s [inherited via %s, shallow]", text, hop.Via.DisplayName)
+	case hop.Via != nil:
+		return fmt.Sprintf("%s [inherited via %s]", text, hop.Via.DisplayName)
+	case hop.FromGrantSources:
+		return fmt.Sprintf("%s [inherited, from grant sources]", text)
+	default:
+		return fmt.Sprintf("%s [inherited]", text)
+	}
+}
+
+func (c *consoleManager) outputAccessExplain(out *v1.AccessExplainOutput) error {
+	if len(out.Paths) == 0 {
+		fmt.Fprintf(os.Stdout, "%s does not hold %s\n", out.Principal.DisplayName, out.Entitlement.DisplayName)
+		return nil
+	}
+
+	// Paths that share their first hops are merged so the output reads as a tree rooted at the principal.
+	type node struct {
+		text     string
+		children []*node
+		index    map[string]*node
+	}
+	root := &node{
+		text:  fmt.Sprintf("%s (%s)", out.Principal.DisplayName, out.Principal.Id.ResourceType),
+		index: make(map[string]*node),
+	}
+	for _, p := range out.Paths {
+		current := root
+		for _, hop := range p.Hops {
+			text := c.accessPathHopText(hop)
+			child, ok := current.index[text]
+			if !ok {
+				child = &node{text: text, index: make(map[string]*node)}
+				current.index[text] = child
+				current.children = append(current.children, child)
+			}
+			current = child
+		}
+	}
+
+	var toTree func(n *node) pterm.TreeNode
+	toTree = func(n *node) pterm.TreeNode {
+		tn := pterm.TreeNode{Text: n.text}
+		for _, child := range n.children {
+			tn.Children = append(tn.Children, toTree(child))
+		}
+		return tn
+	}
+
+	err := pterm.DefaultTree.WithRoot(toTree(root)).Render()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stdout, "%d path(s) found\n", len(out.Paths))
+
+	return nil
+}
+
+func (c *consoleManager) outputWhoCanAccess(out *v1.WhoCanAccessOutput) error {
+	root := pterm.TreeNode{
+		Text: fmt.Sprintf("Access to %s (%s)", out.Resource.DisplayName, out.ResourceType.DisplayName),
+	}
+
+	for _, en := range out.Entitlements {
+		enNode := pterm.TreeNode{
+			Text: fmt.Sprintf("%s (%d principals)", en.Entitlement.DisplayName, len(en.Holders)),
+		}
+
+		for _, h := range en.Holders {
+			var text string
+			switch {
+			case h.Direct:
+				text = fmt.Sprintf("%s (%s) [direct]", h.Principal.DisplayName, h.PrincipalType.DisplayName)
+			case len(h.ViaGroups) > 0:
+				var groups []string
+				for _, g := range h.ViaGroups {
+					groups = append(groups, g.DisplayName)
+				}
+				text = fmt.Sprintf("%s (%s) [inherited via %s]", h.Principal.DisplayName, h.PrincipalType.DisplayName, strings.Join(groups, ", "))
+			default:
+				text = fmt.Sprintf("%s (%s) [inherited]", h.Principal.DisplayName, h.PrincipalType.DisplayName)
+			}
+
+			holderNode := pterm.TreeNode{Text: text}
+			for _, p := range h.Paths {
+				var hops []string
+				for _, hop := range p.Hops {
+					hops = append(hops, c.accessPathHopText(hop))
+				}
+				holderNode.Children = append(holderNode.Children, pterm.TreeNode{Text: strings.Join(hops, " -> ")})
+			}
+
+			enNode.Children = append(enNode.Children, holderNode)
+		}
+
+		root.Children = append(root.Children, enNode)
+	}
+
+	err := pterm.DefaultTree.WithRoot(root).Render()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *consoleManager) outputSodCheck(out *v1.SodCheckOutput) error {
+	if len(out.Violations) == 0 {
+		fmt.Fprintf(os.Stdout, "No separation of duties violations found (%d rules, %d identities, %d files)\n",
+			out.RulesEvaluated, out.IdentitiesEvaluated, len(out.Files))
+		return nil
+	}
+
+	violationsTable := pterm.TableData{
+		{"Rule", "Severity", "Identity", "Conflict", "File", "Entitlement", "Resource", "Access"},
+	}
+	for _, v := range out.Violations {
+		for _, g := range v.Grants {
+			resource := "-"
+			if g.Resource != nil {
+				resource = g.Resource.DisplayName
+			}
+
+			access := "inherited"
+			if g.Direct {
+				access = "direct"
+			}
+
+			violationsTable = append(violationsTable, []string{
+				v.Rule,
+				v.Severity,
+				fmt.Sprintf("%s (%s)", v.DisplayName, v.Identity),
+				g.Conflict,
+				g.File,
+				g.Entitlement.DisplayName,
+				resource,
+				access,
+			})
+		}
+	}
+
+	err := pterm.DefaultTable.WithHasHeader().WithData(violationsTable).Render()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stdout, "\n%d violations found (%d rules, %d identities, %d files)\n",
+		len(out.Violations), out.RulesEvaluated, out.IdentitiesEvaluated, len(out.Files))
+
+	return nil
+}
+
+func (c *consoleManager) outputPrivilegedReport(out *v1.PrivilegedReportOutput) error {
+	accessTable := pterm.TableData{
+		{"File", "Resource", "Entitlement", "Principal", "Email", "Status", "MFA", "Access", "Reason"},
+	}
+	for _, a := range out.Access {
+		resource := "-"
+		if a.Resource != nil {
+			resource = fmt.Sprintf("%s (%s)", a.Resource.DisplayName, a.ResourceType.DisplayName)
+		}
+
+		access := "inherited"
+		if a.Direct {
+			access = "direct"
+		}
+
+		accessTable = append(accessTable, []string{
+			a.File,
+			resource,
+			a.Entitlement.DisplayName,
+			fmt.Sprintf("%s (%s)", a.Principal.DisplayName, a.PrincipalType.DisplayName),
+			a.Email,
+			a.Status,
+			a.Mfa,
+			access,
+			a.Reason,
+		})
+	}
+
+	err := pterm.DefaultTable.WithHasHeader().WithData(accessTable).Render()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stdout, "\n%d privileged entitlements, %d holders\n", out.PrivilegedEntitlements, len(out.Access))
+
+	return nil
+}
+
+func (c *consoleManager) dormantUsersTable(users []*v1.DormantUserOutput) error {
+	usersTable := pterm.TableData{
+		{"File", "User", "Email", "Status", "Last Login", "Created At", "Days Inactive", "Grants", "Privileged Grants"},
+	}
+	for _, u := range users {
+		lastLogin := c.formatTimestamp(u.LastLogin)
+		if u.NeverLoggedIn {
+			lastLogin = "never"
+		}
+
+		usersTable = append(usersTable, []string{
+			u.File,
+			fmt.Sprintf("%s (%s)", u.User.DisplayName, u.ResourceType.DisplayName),
+			u.Email,
+			u.Status,
+			lastLogin,
+			c.formatTimestamp(u.CreatedAt),
+			fmt.Sprintf("%d", u.DaysInactive),
+			fmt.Sprintf("%d", len(u.Entitlements)),
+			fmt.Sprintf("%d", len(u.PrivilegedEntitlements)),
+		})
+	}
+
+	return pterm.DefaultTable.WithHasHeader().WithData(usersTable).Render()
+}
+
+func (c *consoleManager) outputDormantReport(out *v1.DormantReportOutput) error {
+	fmt.Fprintf(os.Stdout, "\n")
+	pterm.DefaultHeader.WithBackgroundStyle(pterm.NewStyle(pterm.BgLightBlue)).Printfln("Users without a login in the last %d days", out.Days)
+	fmt.Fprintf(os.Stdout, "\n")
+
+	err := c.dormantUsersTable(out.Dormant)
+	if err != nil {
+		return err
+	}
+
+	if len(out.NewNeverLoggedIn) > 0 {
+		fmt.Fprintf(os.Stdout, "\n")
+		pterm.DefaultHeader.WithBackgroundStyle(pterm.NewStyle(pterm.BgLightBlue)).Printfln("Users created in the last %d days who have never logged in", out.Days)
+		fmt.Fprintf(os.Stdout, "\n")
+
+		err = c.dormantUsersTable(out.NewNeverLoggedIn)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *consoleManager) percent(n uint32, total uint32) string {
+	if total == 0 {
+		return "0%"
+	}
+
+	return fmt.Sprintf("%.1f%%", float64(n)*100/float64(total))
+}
+
+func (c *consoleManager) outputAuthPostureReport(out *v1.AuthPostureReportOutput) error {
+	postureTable := pterm.TableData{
+		{"File", "Resource Type", "Users", "MFA Enabled", "MFA Disabled", "MFA Unknown", "SSO Enabled", "SSO Disabled", "SSO Unknown"},
+	}
+	addRow := func(file string, resourceType string, b *v1.AuthPostureBreakdown) {
+		postureTable = append(postureTable, []string{
+			file,
+			resourceType,
+			fmt.Sprintf("%d", b.Users),
+			c.percent(b.MfaEnabled, b.Users),
+			c.percent(b.MfaDisabled, b.Users),
+			c.percent(b.MfaUnknown, b.Users),
+			c.percent(b.SsoEnabled, b.Users),
+			c.percent(b.SsoDisabled, b.Users),
+			c.percent(b.SsoUnknown, b.Users),
+		})
+	}
+
+	for _, f := range out.ByFile {
+		for _, b := range out.ByResourceType {
+			if b.File == f.File {
+				addRow(b.File, b.ResourceType.DisplayName, b)
+			}
+		}
+		addRow(f.File, "All", f)
+	}
+	if len(out.ByFile) > 1 {
+		addRow("All", "All", out.Total)
+	}
+
+	err := pterm.DefaultTable.WithHasHeader().WithData(postureTable).Render()
+	if err != nil {
+		return err
+	}
+
+	if len(out.PrivilegedWithoutMfa) == 0 {
+		return nil
+	}
+
+	fmt.Fprintf(os.Stdout, "\n")
+	pterm.DefaultHeader.WithBackgroundStyle(pterm.NewStyle(pterm.BgLightBlue)).Println("Privileged Users Without MFA")
+	fmt.Fprintf(os.Stdout, "\n")
+
+	usersTable := pterm.TableData{
+		{"File", "User", "Email", "Status", "MFA", "Privileged Entitlements"},
+	}
+	for _, u := range out.PrivilegedWithoutMfa {
+		var entitlements []string
+		for _, en := range u.PrivilegedEntitlements {
+			entitlements = append(entitlements, en.DisplayName)
+		}
+
+		usersTable = append(usersTable, []string{
+			u.File,
+			fmt.Sprintf("%s (%s)", u.User.DisplayName, u.ResourceType.DisplayName),
+			u.Email,
+			u.Status,
+			u.Mfa,
+			strings.Join(entitlements, ", "),
+		})
+	}
+
+	return pterm.DefaultTable.WithHasHeader().WithData(usersTable).Render()
+}
+
+func (c *consoleManager) outputOrphans(out *v1.OrphansOutput) error {
+	summaryTable := pterm.TableData{
+		{"Application", "Accounts", "Matched", "Orphaned"},
+	}
+	for _, s := range out.AppsSummary {
+		summaryTable = append(summaryTable, []string{
+			s.File,
+			fmt.Sprintf("%d", s.Accounts),
+			fmt.Sprintf("%d", s.Matched),
+			fmt.Sprintf("%d", s.Orphaned),
+		})
+	}
+
+	fmt.Fprintf(os.Stdout, "Matched against %d users in %s by %s\n\n", out.IdpUsers, out.Idp, strings.Join(out.MatchKeys, ", "))
+	err := pterm.DefaultTable.WithHasHeader().WithData(summaryTable).Render()
+	if err != nil {
+		return err
+	}
+
+	if len(out.Orphans) == 0 {
+		return nil
+	}
+
+	fmt.Fprintf(os.Stdout, "\n")
+	pterm.DefaultHeader.WithBackgroundStyle(pterm.NewStyle(pterm.BgLightBlue)).Println("Orphaned Accounts")
+	fmt.Fprintf(os.Stdout, "\n")
+
+	orphansTable := pterm.TableData{
+		{"Application", "Account", "Email", "Login", "Status", "Entitlements"},
+	}
+	for _, o := range out.Orphans {
+		var entitlements []string
+		for _, en := range o.Entitlements {
+			entitlements = append(entitlements, en.DisplayName)
+		}
+		for _, en := range o.InheritedEntitlements {
+			entitlements = append(entitlements, fmt.Sprintf("%s (inherited)", en.DisplayName))
+		}
+
+		orphansTable = append(orphansTable, []string{
+			o.File,
+			fmt.Sprintf("%s (%s)", o.Account.DisplayName, o.ResourceType.DisplayName),
+			o.Email,
+			o.Login,
+			o.Status,
+			strings.Join(entitlements, ", "),
+		})
+	}
+
+	return pterm.DefaultTable.WithHasHeader().WithData(orphansTable).Render()
+}
+
+func (c *consoleManager) outputLeavers(out *v1.LeaversOutput) error {
+	fmt.Fprintf(os.Stdout, "%d of %d disabled or deleted users in %s still have application access\n\n", len(out.Leavers), out.IdpInactiveUsers, out.Idp)
+	if len(out.Leavers) == 0 {
+		return nil
+	}
+
+	leaversTable := pterm.TableData{
+		{"User", "Email", "Status", "Disabled At", "Application", "Account", "Account Status", "Matched By", "Entitlements"},
+	}
+	for _, l := range out.Leavers {
+		disabledAt := c.formatTimestamp(l.DisabledAt)
+		if disabledAt == "" {
+			disabledAt = "unknown"
+		}
+
+		for _, a := range l.Accounts {
+			var entitlements []string
+			for _, en := range a.Entitlements {
+				entitlements = append(entitlements, en.DisplayName)
+			}
+			for _, en := range a.InheritedEntitlements {
+				entitlements = append(entitlements, fmt.Sprintf("%s (inherited)", en.DisplayName))
+			}
+
+			leaversTable = append(leaversTable, []string{
+				fmt.Sprintf("%s (%s)", l.User.DisplayName, l.ResourceType.DisplayName),
+				l.Email,
+				l.Status,
+				disabledAt,
+				a.File,
+				fmt.Sprintf("%s (%s)", a.Account.DisplayName, a.ResourceType.DisplayName),
+				a.Status,
+				a.MatchKey,
+				strings.Join(entitlements, ", "),
+			})
+		}
+	}
+
+	return pterm.DefaultTable.WithHasHeader().WithData(leaversTable).Render()
+}
+
+func (c *consoleManager) outputSecretsReport(out *v1.SecretsReportOutput) error {
+	secretsTable := pterm.TableData{
+		{"File", "Secret", "Identity", "Created By", "Created At", "Expires At", "Last Used At", "Flags", "Identity Grants"},
+	}
+	for _, o := range out.Secrets {
+		var flags []string
+		if o.Expired {
+			flags = append(flags, "expired")
+		}
+		if o.ExpiringSoon {
+			flags = append(flags, fmt.Sprintf("expires within %d days", out.ExpiringDays))
+		}
+		if o.NeverUsed {
+			flags = append(flags, "never used")
+		}
+		if o.OwnerDeleted {
+			flags = append(flags, "owner deleted")
+		}
+
+		identity := ""
+		if o.Identity != nil {
+			identity = fmt.Sprintf("%s (%s)", o.Identity.DisplayName, o.IdentityResourceType.DisplayName)
+		}
+
+		createdBy := ""
+		if o.CreatedBy != nil {
+			createdBy = o.CreatedBy.DisplayName
+		}
+
+		secretsTable = append(secretsTable, []string{
+			o.File,
+			fmt.Sprintf("%s (%s)", o.Secret.DisplayName, o.ResourceType.DisplayName),
+			identity,
+			createdBy,
+			c.formatTimestamp(o.CreatedAt),
+			c.formatTimestamp(o.ExpiresAt),
+			c.formatTimestamp(o.LastUsedAt),
+			strings.Join(flags, ", "),
+			fmt.Sprintf("%d", len(o.IdentityEntitlements)+len(o.IdentityInheritedEntitlements)),
+		})
+	}
+
+	return pterm.DefaultTable.WithHasHeader().WithData(secretsTable).Render()
+}
+
+func (c *consoleManager) outputServiceAccountsReport(out *v1.ServiceAccountsReportOutput) error {
+	accountsTable := pterm.TableData{
+		{"File", "Account", "Type", "Status", "Last Login", "Secrets", "Grants", "Privileged Grants"},
+	}
+	for _, o := range out.ServiceAccounts {
+		var secrets []string
+		for _, s := range o.Secrets {
+			secrets = append(secrets, s.DisplayName)
+		}
+
+		var privileged []string
+		for _, en := range o.PrivilegedEntitlements {
+			privileged = append(privileged, en.DisplayName)
+		}
+
+		accountsTable = append(accountsTable, []string{
+			o.File,
+			fmt.Sprintf("%s (%s)", o.Account.DisplayName, o.ResourceType.DisplayName),
+			o.AccountType,
+			o.Status,
+			c.formatTimestamp(o.LastLogin),
+			strings.Join(secrets, ", "),
+			fmt.Sprintf("%d", len(o.Entitlements)+len(o.InheritedEntitlements)),
+			strings.Join(privileged, ", "),
+		})
+	}
+
+	return pterm.DefaultTable.WithHasHeader().WithData(accountsTable).Render()
+}
+
+func (c *consoleManager) outputInsights(out *v1.InsightsOutput) error {
+	insightsTable := pterm.TableData{
+		{"Severity", "Score", "Insight", "Type", "Value", "Target", "Resolved To", "Grants", "Risk Factors"},
+	}
+	for _, o := range out.Insights {
+		score := ""
+		if o.InsightType == "risk_score" {
+			score = fmt.Sprintf("%d", o.NormalizedScore)
+		}
+
+		var resolved []string
+		grants := 0
+		for _, t := range o.Targets {
+			resolved = append(resolved, fmt.Sprintf("%s (%s)", t.Resource.DisplayName, t.ResourceType.DisplayName))
+			grants += len(t.Entitlements) + len(t.InheritedEntitlements)
+		}
+
+		insightsTable = append(insightsTable, []string{
+			o.Severity,
+			score,
+			o.Insight.DisplayName,
+			o.InsightType,
+			o.Value,
+			fmt.Sprintf("%s: %s", o.TargetType, o.Target),
+			strings.Join(resolved, ", "),
+			fmt.Sprintf("%d", grants),
+			strings.Join(o.RiskFactors, ", "),
+		})
+	}
+
+	err := pterm.DefaultTable.WithHasHeader().WithData(insightsTable).Render()
+	if err != nil {
+		return err
+	}
+
+	if out.Unresolved > 0 {
+		fmt.Fprintf(os.Stdout, "\n%d insights target resources that were not found in any file\n", out.Unresolved)
+	}
+
+	return nil
+}
+
+func (c *consoleManager) outliersTable(outliers []*v1.OutlierOutput) error {
+	outliersTable := pterm.TableData{
+		{"File", "Peer Group", "User", "Entitlement", "Peers Holding"},
+	}
+	for _, o := range outliers {
+		outliersTable = append(outliersTable, []string{
+			o.File,
+			o.PeerGroup,
+			fmt.Sprintf("%s (%s)", o.User.DisplayName, o.ResourceType.DisplayName),
+			o.Entitlement.DisplayName,
+			fmt.Sprintf("%d of %d (%.1f%%)", o.Holders, o.PeerGroupSize, o.HoldersPercent),
+		})
+	}
+
+	return pterm.DefaultTable.WithHasHeader().WithData(outliersTable).Render()
+}
+
+func (c *consoleManager) outputOutliers(out *v1.OutliersOutput) error {
+	fmt.Fprintf(os.Stdout, "Compared users across %d peer groups by %s\n", out.PeerGroups, out.GroupBy)
+
+	fmt.Fprintf(os.Stdout, "\n")
+	pterm.DefaultHeader.WithBackgroundStyle(pterm.NewStyle(pterm.BgLightBlue)).Printfln("Entitlements held by fewer than %.1f%% of peers", out.Threshold)
+	fmt.Fprintf(os.Stdout, "\n")
+
+	err := c.outliersTable(out.Rare)
+	if err != nil {
+		return err
+	}
+
+	if len(out.MissingBaseline) == 0 {
+		return nil
+	}
+
+	fmt.Fprintf(os.Stdout, "\n")
+	pterm.DefaultHeader.WithBackgroundStyle(pterm.NewStyle(pterm.BgLightBlue)).Printfln("Missing entitlements held by at least %.1f%% of peers", out.Baseline)
+	fmt.Fprintf(os.Stdout, "\n")
+
+	return c.outliersTable(out.MissingBaseline)
+}
+
+func (c *consoleManager) outputRoleMining(out *v1.RoleMiningOutput) error {
+	fmt.Fprintf(os.Stdout, "Found %d candidate roles held by at least %.1f%% of %d users\n", len(out.Candidates), out.MinSupport, out.Users)
+
+	for i, candidate := range out.Candidates {
+		fmt.Fprintf(os.Stdout, "\n")
+		pterm.DefaultHeader.WithBackgroundStyle(pterm.NewStyle(pterm.BgLightBlue)).Printfln(
+			"Role %d: %d entitlements covering %d users (%.1f%%)", i+1, len(candidate.Entitlements), candidate.UsersCovered, candidate.CoveragePercent)
+		fmt.Fprintf(os.Stdout, "\n")
+
+		var entitlements []pterm.BulletListItem
+		for _, en := range candidate.Entitlements {
+			entitlements = append(entitlements, pterm.BulletListItem{Level: 0, Text: fmt.Sprintf("%s (%s)", en.DisplayName, en.Id)})
+		}
+		err := pterm.DefaultBulletList.WithItems(entitlements).Render()
+		if err != nil {
+			return err
+		}
+
+		usersTable := pterm.TableData{
+			{"User", "Exceptions"},
+		}
+		for _, u := range candidate.Users {
+			var exceptions []string
+			for _, en := range u.Exceptions {
+				exceptions = append(exceptions, en.DisplayName)
+			}
+
+			usersTable = append(usersTable, []string{
+				u.User.DisplayName,
+				strings.Join(exceptions, ", "),
+			})
+		}
+
+		err = pterm.DefaultTable.WithHasHeader().WithData(usersTable).Render()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *consoleManager) resourceTreeNode(n *v1.ResourceTreeNode) pterm.TreeNode {
+	text := fmt.Sprintf("%s (%s)", n.Resource.DisplayName, n.ResourceType.DisplayName)
+	if n.Entitlements > 0 || n.Grants > 0 {
+		text = fmt.Sprintf("%s [%d entitlements, %d grants]", text, n.Entitlements, n.Grants)
+	}
+	if n.Truncated {
+		text = fmt.Sprintf("%s ...", text)
+	}
+
+	tn := pterm.TreeNode{Text: text}
+	for _, child := range n.Children {
+		tn.Children = append(tn.Children, c.resourceTreeNode(child))
+	}
+
+	return tn
+}
+
+func (c *consoleManager) outputResourceTree(out *v1.ResourceTreeOutput) error {
+	if len(out.Roots) == 0 {
+		fmt.Fprintf(os.Stdout, "No resources found\n")
+		return nil
+	}
+
+	for _, root := range out.Roots {
+		err := pterm.DefaultTree.WithRoot(c.resourceTreeNode(root)).Render()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *consoleManager) groupsTable(title string, groups []*v1.GroupAnalysisOutput) error {
+	if len(groups) == 0 {
+		return nil
+	}
+
+	fmt.Fprintf(os.Stdout, "\n")
+	pterm.DefaultHeader.WithBackgroundStyle(pterm.NewStyle(pterm.BgLightBlue)).Println(title)
+	fmt.Fprintf(os.Stdout, "\n")
+
+	groupsTable := pterm.TableData{
+		{"Group", "Direct Members", "Direct Groups", "Effective Members", "Nesting Depth", "In Cycle"},
+	}
+	for _, g := range groups {
+		groupsTable = append(groupsTable, []string{
+			fmt.Sprintf("%s (%s)", g.Group.DisplayName, g.ResourceType.DisplayName),
+			fmt.Sprintf("%d", g.DirectMembers),
+			fmt.Sprintf("%d", g.DirectGroups),
+			fmt.Sprintf("%d", g.EffectiveMembers),
+			fmt.Sprintf("%d", g.NestingDepth),
+			fmt.Sprintf("%t", g.InCycle),
+		})
+	}
+
+	return pterm.DefaultTable.WithHasHeader().WithData(groupsTable).Render()
+}
+
+func (c *consoleManager) outputGroupsAnalysis(out *v1.GroupsAnalysisOutput) error {
+	fmt.Fprintf(os.Stdout, "Analyzed %d groups, maximum nesting depth %d\n", out.Groups, out.MaxNestingDepth)
+
+	err := c.groupsTable("Nested Groups", out.Nested)
+	if err != nil {
+		return err
+	}
+
+	if len(out.Cycles) > 0 {
+		fmt.Fprintf(os.Stdout, "\n")
+		pterm.DefaultHeader.WithBackgroundStyle(pterm.NewStyle(pterm.BgLightBlue)).Println("Nesting Cycles")
+		fmt.Fprintf(os.Stdout, "\n")
+
+		for _, cycle := range out.Cycles {
+			var names []string
+			for _, g := range cycle.Groups {
+				names = append(names, g.DisplayName)
+			}
+			fmt.Fprintf(os.Stdout, "%s\n", strings.Join(names, " <-> "))
+		}
+	}
+
+	err = c.groupsTable("Fan-out Groups", out.FanOut)
+	if err != nil {
+		return err
+	}
+
+	return c.groupsTable("Empty Groups", out.Empty)
+}
+
+func (c *consoleManager) entitlementUsageTable(title string, usage []*v1.EntitlementUsageOutput) error {
+	if len(usage) == 0 {
+		return nil
+	}
+
+	fmt.Fprintf(os.Stdout, "\n")
+	pterm.DefaultHeader.WithBackgroundStyle(pterm.NewStyle(pterm.BgLightBlue)).Println(title)
+	fmt.Fprintf(os.Stdout, "\n")
+
+	usageTable := pterm.TableData{
+		{"File", "Entitlement", "Resource", "Direct Holders", "Effective Holders", "By Principal Type"},
+	}
+	for _, u := range usage {
+		resource := ""
+		if u.Resource != nil {
+			resource = fmt.Sprintf("%s (%s)", u.Resource.DisplayName, u.ResourceType.DisplayName)
+		}
+
+		var byType []string
+		for _, t := range u.ByPrincipalType {
+			byType = append(byType, fmt.Sprintf("%s: %d/%d", t.ResourceType.DisplayName, t.Direct, t.Effective))
+		}
+
+		usageTable = append(usageTable, []string{
+			u.File,
+			u.Entitlement.DisplayName,
+			resource,
+			fmt.Sprintf("%d", u.DirectHolders),
+			fmt.Sprintf("%d", u.EffectiveHolders),
+			strings.Join(byType, ", "),
+		})
+	}
+
+	return pterm.DefaultTable.WithHasHeader().WithData(usageTable).Render()
+}
+
+func (c *consoleManager) outputEntitlementUsageReport(out *v1.EntitlementUsageReportOutput) error {
+	fmt.Fprintf(os.Stdout, "%d entitlements: %d unused, %d with a single holder\n", out.Entitlements, len(out.Unused), len(out.SingleHolder))
+
+	if len(out.All) > 0 {
+		return c.entitlementUsageTable("All Entitlements", out.All)
+	}
+
+	err := c.entitlementUsageTable("Most Granted", out.MostGranted)
+	if err != nil {
+		return err
+	}
+
+	err = c.entitlementUsageTable("Single Holder", out.SingleHolder)
+	if err != nil {
+		return err
+	}
+
+	return c.entitlementUsageTable("Unused", out.Unused)
+}
+
+func (c *consoleManager) outputPerson(out *v1.PersonOutput) error {
+	fmt.Fprintf(os.Stdout, "%s (%s)\n", out.DisplayName, out.Key)
+	if len(out.Emails) > 0 {
+		fmt.Fprintf(os.Stdout, "Emails: %s\n", strings.Join(out.Emails, ", "))
+	}
+	fmt.Fprintf(os.Stdout, "%d accounts across %d files, matched by %s\n\n", len(out.Accounts), len(out.Files), strings.Join(out.MatchKeys, ", "))
+
+	accountsTable := pterm.TableData{
+		{"File", "Account", "Email", "Login", "Status", "Matched By", "Entitlements"},
+	}
+	for _, a := range out.Accounts {
+		var entitlements []string
+		for _, en := range a.Entitlements {
+			entitlements = append(entitlements, en.DisplayName)
+		}
+		for _, en := range a.InheritedEntitlements {
+			entitlements = append(entitlements, fmt.Sprintf("%s (inherited)", en.DisplayName))
+		}
+
+		accountsTable = append(accountsTable, []string{
+			a.File,
+			fmt.Sprintf("%s (%s)", a.Account.DisplayName, a.ResourceType.DisplayName),
+			a.Email,
+			a.Login,
+			a.Status,
+			a.MatchedBy,
+			strings.Join(entitlements, ", "),
+		})
+	}
+
+	return pterm.DefaultTable.WithHasHeader().WithData(accountsTable).Render()
+}
+
+func (c *consoleManager) outputReconcile(out *v1.ReconcileOutput) error {
+	fmt.Fprintf(os.Stdout, "%d of %d mappings from %s to %s are in sync, matched by %s\n\n",
+		out.MappingsInSync, len(out.Mappings), out.SourceFile, out.TargetFile, strings.Join(out.MatchKeys, ", "))
+
+	summaryTable := pterm.TableData{
+		{"Mapping", "Source", "Target", "Source Principals", "Target Principals", "Matched", "Missing", "Extra"},
+	}
+	for _, m := range out.Mappings {
+		summaryTable = append(summaryTable, []string{
+			m.Name,
+			m.SourceEntitlement.DisplayName,
+			m.TargetEntitlement.DisplayName,
+			fmt.Sprintf("%d", m.SourcePrincipals),
+			fmt.Sprintf("%d", m.TargetPrincipals),
+			fmt.Sprintf("%d", m.Matched),
+			fmt.Sprintf("%d", len(m.Missing)),
+			fmt.Sprintf("%d", len(m.Extra)),
+		})
+	}
+	summaryTable = append(summaryTable, []string{
+		"Total", "", "", "", "", "", fmt.Sprintf("%d", out.TotalMissing), fmt.Sprintf("%d", out.TotalExtra),
+	})
+
+	err := pterm.DefaultTable.WithHasHeader().WithData(summaryTable).Render()
+	if err != nil {
+		return err
+	}
+
+	if out.TotalMissing == 0 && out.TotalExtra == 0 {
+		return nil
+	}
+
+	fmt.Fprintf(os.Stdout, "\n")
+	pterm.DefaultHeader.WithBackgroundStyle(pterm.NewStyle(pterm.BgLightBlue)).Println("Differences")
+	fmt.Fprintf(os.Stdout, "\n")
+
+	differencesTable := pterm.TableData{
+		{"Mapping", "Difference", "Principal", "Resource Type"},
+	}
+	for _, m := range out.Mappings {
+		for _, r := range m.Missing {
+			differencesTable = append(differencesTable, []string{m.Name, "missing", r.Resource.DisplayName, r.ResourceType.DisplayName})
+		}
+		for _, r := range m.Extra {
+			differencesTable = append(differencesTable, []string{m.Name, "extra", r.Resource.DisplayName, r.ResourceType.DisplayName})
+		}
+	}
+
+	return pterm.DefaultTable.WithHasHeader().WithData(differencesTable).Render()
+}
+
 func (c *consoleManager) outputPrincipalsCompare(out *v1.PrincipalsCompareOutput) error {
+	if len(out.Matched) > 0 {
+		fmt.Fprintf(os.Stdout, "Matched %d of %d principals by %s\n\n", len(out.Matched), len(out.Base), strings.Join(out.MatchKeys, ", "))
+
+		matchedTable := pterm.TableData{
+			{"Base Principal", "Compared Principal", "Match Key"},
+		}
+		for _, m := range out.Matched {
+			matchedTable = append(matchedTable, []string{
+				fmt.Sprintf("%s (%s)", m.Base.Resource.DisplayName, m.Base.ResourceType.DisplayName),
+				fmt.Sprintf("%s (%s)", m.Compared.Resource.DisplayName, m.Compared.ResourceType.DisplayName),
+				m.MatchKey,
+			})
+		}
+
+		err := pterm.DefaultTable.WithHasHeader().WithData(matchedTable).Render()
+		if err != nil {
+			return err
+		}
+	}
+
 	if len(out.Missing) == 0 && len(out.Extra) == 0 {
 		fmt.Fprintf(os.Stdout, "The principals between these entitlements appear to match!")
 		return nil
@@ -0,0 +1,892 @@
+package output
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/conductorone/baton/pb/baton/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
+)
+
+type csvManager struct{}
+
+func (c *csvManager) Output(ctx context.Context, out interface{}) error {
+	var rows [][]string
+	var nextPageToken string
+	switch obj := out.(type) {
+	case *v1.ResourceTypeListOutput:
+		rows = c.resourceTypeRows(obj)
+		nextPageToken = obj.NextPageToken
+
+	case *v1.ResourceListOutput:
+		rows = c.resourceRows(obj)
+		nextPageToken = obj.NextPageToken
+
+	case *v1.EntitlementListOutput:
+		rows = c.entitlementRows(obj)
+		nextPageToken = obj.NextPageToken
+
+	case *v1.GrantListOutput:
+		rows = c.grantRows(obj)
+		nextPageToken = obj.NextPageToken
+
+	case *v1.ResourceAccessListOutput:
+		rows = c.resourceAccessRows(obj)
+
+	case *v1.PrincipalsCompareOutput:
+		rows = c.principalsCompareRows(obj)
+
+	case *v1.SyncListOutput:
+		rows = c.syncRows(obj)
+		nextPageToken = obj.NextPageToken
+
+	case *v1.CountOutput:
+		rows = [][]string{{"Count"}, {strconv.FormatUint(uint64(obj.Count), 10)}}
+		nextPageToken = obj.NextPageToken
+
+	case *v1.AccessExplainOutput:
+		rows = c.accessExplainRows(obj)
+
+	case *v1.WhoCanAccessOutput:
+		rows = c.whoCanAccessRows(obj)
+
+	case *v1.SodCheckOutput:
+		rows = c.sodRows(obj)
+
+	case *v1.ResourceTreeOutput:
+		rows = c.resourceTreeRows(obj)
+
+	case *v1.PrivilegedReportOutput:
+		rows = c.privilegedRows(obj)
+
+	case *v1.DormantReportOutput:
+		rows = c.dormantRows(obj)
+
+	case *v1.AuthPostureReportOutput:
+		rows = c.authPostureRows(obj)
+
+	case *v1.OrphansOutput:
+		rows = c.orphanRows(obj)
+
+	case *v1.LeaversOutput:
+		rows = c.leaverRows(obj)
+
+	case *v1.SecretsReportOutput:
+		rows = c.secretRows(obj)
+
+	case *v1.ServiceAccountsReportOutput:
+		rows = c.serviceAccountRows(obj)
+
+	case *v1.InsightsOutput:
+		rows = c.insightRows(obj)
+
+	case *v1.OutliersOutput:
+		rows = c.outlierRows(obj)
+
+	case *v1.RoleMiningOutput:
+		rows = c.roleMiningRows(obj)
+
+	case *v1.GroupsAnalysisOutput:
+		rows = c.groupsAnalysisRows(obj)
+
+	case *v1.EntitlementUsageReportOutput:
+		rows = c.entitlementUsageRows(obj)
+
+	case *v1.PersonOutput:
+		rows = c.personRows(obj)
+
+	case *v1.ReconcileOutput:
+		rows = c.reconcileRows(obj)
+
+	default:
+		return fmt.Errorf("csv output is not supported for this command")
+	}
+
+	w := csv.NewWriter(os.Stdout)
+	err := w.WriteAll(rows)
+	if err != nil {
+		return err
+	}
+
+	// The page token is written to stderr, as in console output, so that stdout only holds the CSV.
+	if nextPageToken != "" {
+		fmt.Fprintf(os.Stderr, "Next page token: %s\n", nextPageToken)
+	}
+
+	return nil
+}
+
+func (c *csvManager) displayName(r interface{ GetDisplayName() string }) string {
+	if r == nil {
+		return ""
+	}
+
+	return r.GetDisplayName()
+}
+
+func (c *csvManager) formatTimestamp(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+
+	return ts.AsTime().Format(time.RFC3339)
+}
+
+func (c *csvManager) resourceID(r *v2.Resource) string {
+	if r == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%s:%s", r.Id.ResourceType, r.Id.Resource)
+}
+
+func (c *csvManager) entitlementIDs(entitlements []*v2.Entitlement) string {
+	ids := make([]string, 0, len(entitlements))
+	for _, en := range entitlements {
+		ids = append(ids, en.Id)
+	}
+
+	return strings.Join(ids, ";")
+}
+
+func (c *csvManager) privilegedRows(out *v1.PrivilegedReportOutput) [][]string {
+	rows := [][]string{
+		{
+			"File", "Resource Type", "Resource", "Entitlement ID", "Entitlement", "Reason",
+			"Principal Type", "Principal ID", "Principal", "Email", "Status", "MFA", "Direct",
+		},
+	}
+
+	for _, a := range out.Access {
+		rows = append(rows, []string{
+			a.File,
+			c.displayName(a.ResourceType),
+			c.displayName(a.Resource),
+			a.Entitlement.Id,
+			a.Entitlement.DisplayName,
+			a.Reason,
+			c.displayName(a.PrincipalType),
+			a.Principal.Id.Resource,
+			a.Principal.DisplayName,
+			a.Email,
+			a.Status,
+			a.Mfa,
+			strconv.FormatBool(a.Direct),
+		})
+	}
+
+	return rows
+}
+
+func (c *csvManager) dormantRows(out *v1.DormantReportOutput) [][]string {
+	rows := [][]string{
+		{
+			"File", "Category", "Resource Type", "User ID", "User", "Email", "Status", "Last Login", "Created At",
+			"Days Inactive", "Grants", "Privileged Grants", "Privileged Entitlement IDs",
+		},
+	}
+
+	add := func(category string, users []*v1.DormantUserOutput) {
+		for _, u := range users {
+			rows = append(rows, []string{
+				u.File,
+				category,
+				c.displayName(u.ResourceType),
+				u.User.Id.Resource,
+				u.User.DisplayName,
+				u.Email,
+				u.Status,
+				c.formatTimestamp(u.LastLogin),
+				c.formatTimestamp(u.CreatedAt),
+				strconv.FormatUint(uint64(u.DaysInactive), 10),
+				strconv.Itoa(len(u.Entitlements)),
+				strconv.Itoa(len(u.PrivilegedEntitlements)),
+				c.entitlementIDs(u.PrivilegedEntitlements),
+			})
+		}
+	}
+	add("dormant", out.Dormant)
+	add("new_never_logged_in", out.NewNeverLoggedIn)
+
+	return rows
+}
+
+func (c *csvManager) authPostureRows(out *v1.AuthPostureReportOutput) [][]string {
+	rows := [][]string{
+		{
+			"File", "Resource Type", "Users", "MFA Enabled", "MFA Disabled", "MFA Unknown",
+			"SSO Enabled", "SSO Disabled", "SSO Unknown",
+		},
+	}
+
+	add := func(file string, b *v1.AuthPostureBreakdown) {
+		rows = append(rows, []string{
+			file,
+			c.displayName(b.ResourceType),
+			strconv.FormatUint(uint64(b.Users), 10),
+			strconv.FormatUint(uint64(b.MfaEnabled), 10),
+			strconv.FormatUint(uint64(b.MfaDisabled), 10),
+			strconv.FormatUint(uint64(b.MfaUnknown), 10),
+			strconv.FormatUint(uint64(b.SsoEnabled), 10),
+			strconv.FormatUint(uint64(b.SsoDisabled), 10),
+			strconv.FormatUint(uint64(b.SsoUnknown), 10),
+		})
+	}
+
+	for _, b := range out.ByResourceType {
+		add(b.File, b)
+	}
+	for _, b := range out.ByFile {
+		add(b.File, b)
+	}
+	if out.Total != nil {
+		add("", out.Total)
+	}
+
+	return rows
+}
+
+func (c *csvManager) orphanRows(out *v1.OrphansOutput) [][]string {
+	rows := [][]string{
+		{
+			"File", "Resource Type", "Account ID", "Account", "Email", "Login", "Status", "Account Type",
+			"Entitlement IDs", "Inherited Entitlement IDs",
+		},
+	}
+
+	for _, o := range out.Orphans {
+		rows = append(rows, []string{
+			o.File,
+			c.displayName(o.ResourceType),
+			o.Account.Id.Resource,
+			o.Account.DisplayName,
+			o.Email,
+			o.Login,
+			o.Status,
+			o.AccountType,
+			c.entitlementIDs(o.Entitlements),
+			c.entitlementIDs(o.InheritedEntitlements),
+		})
+	}
+
+	return rows
+}
+
+func (c *csvManager) leaverRows(out *v1.LeaversOutput) [][]string {
+	rows := [][]string{
+		{
+			"User ID", "User", "Email", "Status", "Disabled At", "Last Seen Enabled At", "File", "Resource Type",
+			"Account ID", "Account", "Account Status", "Match Key", "Entitlement IDs", "Inherited Entitlement IDs",
+		},
+	}
+
+	for _, l := range out.Leavers {
+		for _, a := range l.Accounts {
+			rows = append(rows, []string{
+				l.User.Id.Resource,
+				l.User.DisplayName,
+				l.Email,
+				l.Status,
+				c.formatTimestamp(l.DisabledAt),
+				c.formatTimestamp(l.LastSeenEnabledAt),
+				a.File,
+				c.displayName(a.ResourceType),
+				a.Account.Id.Resource,
+				a.Account.DisplayName,
+				a.Status,
+				a.MatchKey,
+				c.entitlementIDs(a.Entitlements),
+				c.entitlementIDs(a.InheritedEntitlements),
+			})
+		}
+	}
+
+	return rows
+}
+
+func (c *csvManager) secretRows(out *v1.SecretsReportOutput) [][]string {
+	rows := [][]string{
+		{
+			"File", "Resource Type", "Secret ID", "Secret", "Identity", "Identity Status", "Created By", "Created At",
+			"Expires At", "Last Used At", "Expired", "Expiring Soon", "Never Used", "Owner Deleted",
+			"Identity Entitlement IDs", "Identity Inherited Entitlement IDs",
+		},
+	}
+
+	for _, o := range out.Secrets {
+		rows = append(rows, []string{
+			o.File,
+			c.displayName(o.ResourceType),
+			o.Secret.Id.Resource,
+			o.Secret.DisplayName,
+			c.resourceID(o.Identity),
+			o.IdentityStatus,
+			c.resourceID(o.CreatedBy),
+			c.formatTimestamp(o.CreatedAt),
+			c.formatTimestamp(o.ExpiresAt),
+			c.formatTimestamp(o.LastUsedAt),
+			strconv.FormatBool(o.Expired),
+			strconv.FormatBool(o.ExpiringSoon),
+			strconv.FormatBool(o.NeverUsed),
+			strconv.FormatBool(o.OwnerDeleted),
+			c.entitlementIDs(o.IdentityEntitlements),
+			c.entitlementIDs(o.IdentityInheritedEntitlements),
+		})
+	}
+
+	return rows
+}
+
+func (c *csvManager) serviceAccountRows(out *v1.ServiceAccountsReportOutput) [][]string {
+	rows := [][]string{
+		{
+			"File", "Resource Type", "Account ID", "Account", "Account Type", "Status", "Created At", "Last Login",
+			"Secret IDs", "Entitlement IDs", "Inherited Entitlement IDs", "Privileged Entitlement IDs",
+		},
+	}
+
+	for _, o := range out.ServiceAccounts {
+		var secretIDs []string
+		for _, s := range o.Secrets {
+			secretIDs = append(secretIDs, c.resourceID(s))
+		}
+
+		rows = append(rows, []string{
+			o.File,
+			c.displayName(o.ResourceType),
+			o.Account.Id.Resource,
+			o.Account.DisplayName,
+			o.AccountType,
+			o.Status,
+			c.formatTimestamp(o.CreatedAt),
+			c.formatTimestamp(o.LastLogin),
+			strings.Join(secretIDs, ";"),
+			c.entitlementIDs(o.Entitlements),
+			c.entitlementIDs(o.InheritedEntitlements),
+			c.entitlementIDs(o.PrivilegedEntitlements),
+		})
+	}
+
+	return rows
+}
+
+// insightRows outputs a row for every resolved target of an insight, or a single row if the target was not found.
+func (c *csvManager) insightRows(out *v1.InsightsOutput) [][]string {
+	rows := [][]string{
+		{
+			"File", "Insight ID", "Insight", "Type", "Severity", "Normalized Score", "Value", "Risk Factors", "Observed At",
+			"Target Type", "Target", "Target File", "Target Resource", "Entitlement IDs", "Inherited Entitlement IDs",
+		},
+	}
+
+	for _, o := range out.Insights {
+		row := []string{
+			o.File,
+			o.Insight.Id.Resource,
+			o.Insight.DisplayName,
+			o.InsightType,
+			o.Severity,
+			strconv.FormatUint(uint64(o.NormalizedScore), 10),
+			o.Value,
+			strings.Join(o.RiskFactors, ";"),
+			c.formatTimestamp(o.ObservedAt),
+			o.TargetType,
+			o.Target,
+		}
+
+		if len(o.Targets) == 0 {
+			rows = append(rows, append(row, "", "", "", ""))
+			continue
+		}
+
+		for _, t := range o.Targets {
+			rows = append(rows, append(slices.Clone(row),
+				t.File,
+				c.resourceID(t.Resource),
+				c.entitlementIDs(t.Entitlements),
+				c.entitlementIDs(t.InheritedEntitlements),
+			))
+		}
+	}
+
+	return rows
+}
+
+func (c *csvManager) outlierRows(out *v1.OutliersOutput) [][]string {
+	rows := [][]string{
+		{
+			"File", "Category", "Peer Group", "Peer Group Size", "Resource Type", "User ID", "User", "Entitlement ID",
+			"Entitlement", "Holders", "Holders Percent",
+		},
+	}
+
+	add := func(category string, outliers []*v1.OutlierOutput) {
+		for _, o := range outliers {
+			rows = append(rows, []string{
+				o.File,
+				category,
+				o.PeerGroup,
+				strconv.FormatUint(uint64(o.PeerGroupSize), 10),
+				c.displayName(o.ResourceType),
+				o.User.Id.Resource,
+				o.User.DisplayName,
+				o.Entitlement.Id,
+				o.Entitlement.DisplayName,
+				strconv.FormatUint(uint64(o.Holders), 10),
+				strconv.FormatFloat(o.HoldersPercent, 'f', 2, 64),
+			})
+		}
+	}
+	add("rare", out.Rare)
+	add("missing_baseline", out.MissingBaseline)
+
+	return rows
+}
+
+// roleMiningRows outputs a row for every user covered by every candidate role.
+func (c *csvManager) roleMiningRows(out *v1.RoleMiningOutput) [][]string {
+	rows := [][]string{
+		{"Role", "Entitlement IDs", "Users Covered", "Coverage Percent", "User ID", "User", "Exception Entitlement IDs"},
+	}
+
+	for i, candidate := range out.Candidates {
+		for _, u := range candidate.Users {
+			rows = append(rows, []string{
+				strconv.Itoa(i + 1),
+				c.entitlementIDs(candidate.Entitlements),
+				strconv.FormatUint(uint64(candidate.UsersCovered), 10),
+				strconv.FormatFloat(candidate.CoveragePercent, 'f', 2, 64),
+				c.resourceID(u.User),
+				u.User.DisplayName,
+				c.entitlementIDs(u.Exceptions),
+			})
+		}
+	}
+
+	return rows
+}
+
+func (c *csvManager) groupsAnalysisRows(out *v1.GroupsAnalysisOutput) [][]string {
+	rows := [][]string{
+		{
+			"Category", "Resource Type", "Group ID", "Group", "Direct Members", "Direct Groups", "Effective Members",
+			"Nesting Depth", "In Cycle",
+		},
+	}
+
+	add := func(category string, groups []*v1.GroupAnalysisOutput) {
+		for _, g := range groups {
+			rows = append(rows, []string{
+				category,
+				c.displayName(g.ResourceType),
+				g.Group.Id.Resource,
+				g.Group.DisplayName,
+				strconv.FormatUint(uint64(g.DirectMembers), 10),
+				strconv.FormatUint(uint64(g.DirectGroups), 10),
+				strconv.FormatUint(uint64(g.EffectiveMembers), 10),
+				strconv.FormatUint(uint64(g.NestingDepth), 10),
+				strconv.FormatBool(g.InCycle),
+			})
+		}
+	}
+	add("nested", out.Nested)
+	add("fan_out", out.FanOut)
+	add("empty", out.Empty)
+
+	return rows
+}
+
+// entitlementUsageRows outputs every entitlement when the report has them, and the notable ones otherwise.
+func (c *csvManager) entitlementUsageRows(out *v1.EntitlementUsageReportOutput) [][]string {
+	rows := [][]string{
+		{
+			"File", "Category", "Entitlement ID", "Entitlement", "Resource Type", "Resource", "Direct Holders",
+			"Effective Holders", "By Principal Type",
+		},
+	}
+
+	add := func(category string, usage []*v1.EntitlementUsageOutput) {
+		for _, u := range usage {
+			var byType []string
+			for _, t := range u.ByPrincipalType {
+				byType = append(byType, fmt.Sprintf("%s=%d/%d", t.ResourceType.GetId(), t.Direct, t.Effective))
+			}
+
+			rows = append(rows, []string{
+				u.File,
+				category,
+				u.Entitlement.Id,
+				u.Entitlement.DisplayName,
+				c.displayName(u.ResourceType),
+				c.resourceID(u.Resource),
+				strconv.FormatUint(uint64(u.DirectHolders), 10),
+				strconv.FormatUint(uint64(u.EffectiveHolders), 10),
+				strings.Join(byType, ";"),
+			})
+		}
+	}
+
+	if len(out.All) > 0 {
+		add("all", out.All)
+		return rows
+	}
+	add("most_granted", out.MostGranted)
+	add("single_holder", out.SingleHolder)
+	add("unused", out.Unused)
+
+	return rows
+}
+
+func (c *csvManager) personRows(out *v1.PersonOutput) [][]string {
+	rows := [][]string{
+		{
+			"Person", "File", "Resource Type", "Account ID", "Account", "Email", "Login", "Status", "Matched By",
+			"Entitlement IDs", "Inherited Entitlement IDs",
+		},
+	}
+
+	for _, a := range out.Accounts {
+		rows = append(rows, []string{
+			out.Key,
+			a.File,
+			c.displayName(a.ResourceType),
+			a.Account.Id.Resource,
+			a.Account.DisplayName,
+			a.Email,
+			a.Login,
+			a.Status,
+			a.MatchedBy,
+			c.entitlementIDs(a.Entitlements),
+			c.entitlementIDs(a.InheritedEntitlements),
+		})
+	}
+
+	return rows
+}
+
+func (c *csvManager) reconcileRows(out *v1.ReconcileOutput) [][]string {
+	rows := [][]string{
+		{
+			"Mapping", "Source Entitlement ID", "Target Entitlement ID", "Difference", "Resource Type", "Principal ID",
+			"Principal",
+		},
+	}
+
+	for _, m := range out.Mappings {
+		add := func(difference string, principals []*v1.ResourceOutput) {
+			for _, r := range principals {
+				rows = append(rows, []string{
+					m.Name,
+					m.SourceEntitlement.Id,
+					m.TargetEntitlement.Id,
+					difference,
+					c.displayName(r.ResourceType),
+					r.Resource.Id.Resource,
+					r.Resource.DisplayName,
+				})
+			}
+		}
+		add("missing", m.Missing)
+		add("extra", m.Extra)
+	}
+
+	return rows
+}
+
+func (c *csvManager) resourceTypeRows(out *v1.ResourceTypeListOutput) [][]string {
+	rows := [][]string{
+		{"ID", "Display Name", "Traits"},
+	}
+
+	for _, o := range out.ResourceTypes {
+		var traits []string
+		for _, t := range o.ResourceType.Traits {
+			traits = append(traits, t.String())
+		}
+
+		rows = append(rows, []string{
+			o.ResourceType.Id,
+			o.ResourceType.DisplayName,
+			strings.Join(traits, ";"),
+		})
+	}
+
+	return rows
+}
+
+func (c *csvManager) resourceRows(out *v1.ResourceListOutput) [][]string {
+	rows := [][]string{
+		{"Resource Type", "ID", "Display Name", "Parent ID", "Parent"},
+	}
+
+	for _, r := range out.Resources {
+		rows = append(rows, []string{
+			c.displayName(r.ResourceType),
+			r.Resource.Id.Resource,
+			r.Resource.DisplayName,
+			c.resourceID(r.Parent),
+			c.displayName(r.Parent),
+		})
+	}
+
+	return rows
+}
+
+func (c *csvManager) entitlementRows(out *v1.EntitlementListOutput) [][]string {
+	rows := [][]string{
+		{"ID", "Display Name", "Resource Type", "Resource ID", "Resource", "Slug"},
+	}
+
+	for _, e := range out.Entitlements {
+		rows = append(rows, []string{
+			e.Entitlement.Id,
+			e.Entitlement.DisplayName,
+			c.displayName(e.ResourceType),
+			c.resourceID(e.Resource),
+			c.displayName(e.Resource),
+			e.Entitlement.Slug,
+		})
+	}
+
+	return rows
+}
+
+func (c *csvManager) grantRows(out *v1.GrantListOutput) [][]string {
+	rows := [][]string{
+		{"ID", "Resource Type", "Resource ID", "Resource", "Entitlement ID", "Entitlement", "Principal ID", "Principal"},
+	}
+
+	for _, g := range out.Grants {
+		rows = append(rows, []string{
+			g.Grant.Id,
+			c.displayName(g.ResourceType),
+			c.resourceID(g.Resource),
+			c.displayName(g.Resource),
+			g.Entitlement.GetId(),
+			c.displayName(g.Entitlement),
+			c.resourceID(g.Principal),
+			c.displayName(g.Principal),
+		})
+	}
+
+	return rows
+}
+
+func (c *csvManager) resourceAccessRows(out *v1.ResourceAccessListOutput) [][]string {
+	rows := [][]string{
+		{"Principal ID", "Principal", "Resource Type", "Resource ID", "Resource", "Entitlement ID", "Entitlement", "Slug", "Direct"},
+	}
+
+	for _, a := range out.Access {
+		add := func(direct bool, entitlements []*v2.Entitlement) {
+			for _, en := range entitlements {
+				rows = append(rows, []string{
+					c.resourceID(out.Principal),
+					c.displayName(out.Principal),
+					c.displayName(a.ResourceType),
+					c.resourceID(a.Resource),
+					c.displayName(a.Resource),
+					en.Id,
+					en.DisplayName,
+					en.Slug,
+					strconv.FormatBool(direct),
+				})
+			}
+		}
+		add(true, a.Entitlements)
+		add(false, a.InheritedEntitlements)
+	}
+
+	return rows
+}
+
+func (c *csvManager) principalsCompareRows(out *v1.PrincipalsCompareOutput) [][]string {
+	rows := [][]string{
+		{"Result", "Resource Type", "Principal ID", "Principal", "Match Key", "Matched Principal ID", "Matched Principal"},
+	}
+
+	for _, m := range out.Matched {
+		rows = append(rows, []string{
+			"matched",
+			c.displayName(m.Base.ResourceType),
+			c.resourceID(m.Base.Resource),
+			c.displayName(m.Base.Resource),
+			m.MatchKey,
+			c.resourceID(m.Compared.Resource),
+			c.displayName(m.Compared.Resource),
+		})
+	}
+
+	add := func(result string, principals []*v1.ResourceOutput) {
+		for _, r := range principals {
+			rows = append(rows, []string{
+				result,
+				c.displayName(r.ResourceType),
+				c.resourceID(r.Resource),
+				c.displayName(r.Resource),
+				"",
+				"",
+				"",
+			})
+		}
+	}
+	add("missing", out.Missing)
+	add("extra", out.Extra)
+
+	return rows
+}
+
+func (c *csvManager) syncRows(out *v1.SyncListOutput) [][]string {
+	rows := [][]string{
+		{"ID", "Started At", "Ended At", "Type", "Parent ID", "Token"},
+	}
+
+	for _, o := range out.Syncs {
+		rows = append(rows, []string{
+			o.Id,
+			c.formatTimestamp(o.StartedAt),
+			c.formatTimestamp(o.EndedAt),
+			o.SyncType,
+			o.ParentSyncId,
+			o.SyncToken,
+		})
+	}
+
+	return rows
+}
+
+// accessPathRows returns a row for every hop of every path, numbering paths from 1.
+func (c *csvManager) accessPathRows(prefix []string, paths []*v1.AccessPath) [][]string {
+	var rows [][]string
+	for i, p := range paths {
+		for j, hop := range p.Hops {
+			row := append(slices.Clone(prefix),
+				strconv.Itoa(i+1),
+				strconv.Itoa(j+1),
+				hop.Entitlement.GetId(),
+				c.displayName(hop.Entitlement),
+				c.displayName(hop.ResourceType),
+				c.resourceID(hop.Resource),
+				c.displayName(hop.Resource),
+				strconv.FormatBool(hop.Direct),
+				strconv.FormatBool(hop.Shallow),
+				c.resourceID(hop.Via),
+				strconv.FormatBool(hop.FromGrantSources),
+			)
+			rows = append(rows, row)
+		}
+	}
+
+	return rows
+}
+
+var csvAccessPathHeaders = []string{
+	"Path", "Hop", "Entitlement ID", "Entitlement", "Resource Type", "Resource ID", "Resource", "Direct", "Shallow",
+	"Via", "From Grant Sources",
+}
+
+func (c *csvManager) accessExplainRows(out *v1.AccessExplainOutput) [][]string {
+	rows := [][]string{
+		append([]string{"Principal ID", "Principal"}, csvAccessPathHeaders...),
+	}
+
+	return append(rows, c.accessPathRows([]string{c.resourceID(out.Principal), c.displayName(out.Principal)}, out.Paths)...)
+}
+
+func (c *csvManager) whoCanAccessRows(out *v1.WhoCanAccessOutput) [][]string {
+	rows := [][]string{
+		{"Resource ID", "Entitlement ID", "Entitlement", "Principal Type", "Principal ID", "Principal", "Direct", "Via Groups"},
+	}
+
+	for _, en := range out.Entitlements {
+		for _, h := range en.Holders {
+			var groups []string
+			for _, g := range h.ViaGroups {
+				groups = append(groups, c.resourceID(g))
+			}
+
+			rows = append(rows, []string{
+				c.resourceID(out.Resource),
+				en.Entitlement.Id,
+				en.Entitlement.DisplayName,
+				c.displayName(h.PrincipalType),
+				c.resourceID(h.Principal),
+				c.displayName(h.Principal),
+				strconv.FormatBool(h.Direct),
+				strings.Join(groups, ";"),
+			})
+		}
+	}
+
+	return rows
+}
+
+func (c *csvManager) sodRows(out *v1.SodCheckOutput) [][]string {
+	rows := [][]string{
+		{
+			"Rule", "Severity", "Identity", "Display Name", "Conflict", "File", "Principal ID", "Entitlement ID",
+			"Entitlement", "Resource ID", "Resource", "Direct",
+		},
+	}
+
+	for _, v := range out.Violations {
+		for _, g := range v.Grants {
+			rows = append(rows, []string{
+				v.Rule,
+				v.Severity,
+				v.Identity,
+				v.DisplayName,
+				g.Conflict,
+				g.File,
+				c.resourceID(g.Principal),
+				g.Entitlement.GetId(),
+				c.displayName(g.Entitlement),
+				c.resourceID(g.Resource),
+				c.displayName(g.Resource),
+				strconv.FormatBool(g.Direct),
+			})
+		}
+	}
+
+	return rows
+}
+
+func (c *csvManager) resourceTreeRows(out *v1.ResourceTreeOutput) [][]string {
+	rows := [][]string{
+		{
+			"Depth", "Resource Type", "Resource ID", "Resource", "Parent ID", "Child Resource Types", "Entitlements",
+			"Grants", "Truncated",
+		},
+	}
+
+	var add func(depth int, parent *v2.Resource, n *v1.ResourceTreeNode)
+	add = func(depth int, parent *v2.Resource, n *v1.ResourceTreeNode) {
+		rows = append(rows, []string{
+			strconv.Itoa(depth),
+			c.displayName(n.ResourceType),
+			c.resourceID(n.Resource),
+			c.displayName(n.Resource),
+			c.resourceID(parent),
+			strings.Join(n.ChildResourceTypes, ";"),
+			strconv.FormatUint(uint64(n.Entitlements), 10),
+			strconv.FormatUint(uint64(n.Grants), 10),
+			strconv.FormatBool(n.Truncated),
+		})
+		for _, child := range n.Children {
+			add(depth+1, n.Resource, child)
+		}
+	}
+	for _, root := range out.Roots {
+		add(0, nil, root)
+	}
+
+	return rows
+}
@@ -14,6 +14,8 @@ func NewManager(ctx context.Context, format string) Manager {
 		return &consoleManager{}
 	case "json":
 		return &jsonManager{}
+	case "csv":
+		return &csvManager{}
 	default:
 		return &consoleManager{}
 	}
@@ -0,0 +1,199 @@
+// Package parquet writes flat Parquet files with parquet-go. Every column is optional and holds strings, timestamps or
+// booleans. Rows are buffered into row groups, so memory use is bounded by the row group size rather than the file
+// size.
+package parquet
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+// defaultRowGroupSize is the number of rows buffered before a row group is written.
+const defaultRowGroupSize = 50000
+
+// Type is the type of a column's values.
+type Type int
+
+const (
+	// String columns hold UTF-8 strings.
+	String Type = iota
+	// Timestamp columns hold UTC timestamps with millisecond precision.
+	Timestamp
+	// Bool columns hold booleans.
+	Bool
+)
+
+// Column describes a column of a file. Doc is written to the file's key-value metadata as "doc.<name>".
+type Column struct {
+	Name string
+	Type Type
+	Doc  string
+}
+
+// Writer writes rows to a Parquet file. Close must be called to write the footer.
+type Writer struct {
+	pw           *parquet.Writer
+	columns      []Column
+	rowGroupSize int
+	rows         int
+}
+
+// NewWriter returns a writer for rows with the given columns.
+func NewWriter(w io.Writer, columns []Column) (*Writer, error) {
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("parquet: at least one column is required")
+	}
+
+	schema, err := schemaOf(columns)
+	if err != nil {
+		return nil, err
+	}
+
+	options := []parquet.WriterOption{schema}
+	for _, c := range columns {
+		if c.Doc != "" {
+			options = append(options, parquet.KeyValueMetadata("doc."+c.Name, c.Doc))
+		}
+	}
+
+	return &Writer{
+		pw:           parquet.NewWriter(w, options...),
+		columns:      columns,
+		rowGroupSize: defaultRowGroupSize,
+	}, nil
+}
+
+// schemaOf builds the schema from a struct type with a field per column. Struct fields keep the order of the columns,
+// where a parquet.Group would sort them by name.
+func schemaOf(columns []Column) (*parquet.Schema, error) {
+	fields := make([]reflect.StructField, len(columns))
+	for i, c := range columns {
+		if c.Name == "" || strings.ContainsAny(c.Name, `,"`) {
+			return nil, fmt.Errorf("parquet: invalid column name %q", c.Name)
+		}
+
+		tag := c.Name + ",optional"
+		var typ reflect.Type
+		switch c.Type {
+		case String:
+			typ = reflect.TypeOf("")
+		case Timestamp:
+			typ = reflect.TypeOf(int64(0))
+			tag += ",timestamp(millisecond)"
+		case Bool:
+			typ = reflect.TypeOf(false)
+		default:
+			return nil, fmt.Errorf("parquet: column %s has an unknown type", c.Name)
+		}
+
+		fields[i] = reflect.StructField{
+			Name: fmt.Sprintf("Column%d", i),
+			Type: typ,
+			Tag:  reflect.StructTag(`parquet:"` + tag + `"`),
+		}
+	}
+
+	return parquet.SchemaOf(reflect.New(reflect.StructOf(fields)).Interface()), nil
+}
+
+// SetMetadata adds a key-value pair to the file metadata.
+func (w *Writer) SetMetadata(key string, value string) {
+	w.pw.SetKeyValueMetadata(key, value)
+}
+
+// Write adds a row. Values must be in column order and are either nil, for a null, or of the column's type: string,
+// time.Time or bool.
+func (w *Writer) Write(values ...interface{}) error {
+	if len(values) != len(w.columns) {
+		return fmt.Errorf("parquet: row has %d values, expected %d", len(values), len(w.columns))
+	}
+
+	for i, v := range values {
+		err := w.columns[i].check(v)
+		if err != nil {
+			return err
+		}
+	}
+
+	row := make(parquet.Row, len(values))
+	for i, v := range values {
+		var pv parquet.Value
+		switch v := v.(type) {
+		case nil:
+			row[i] = parquet.NullValue().Level(0, 0, i)
+			continue
+		case string:
+			pv = parquet.ByteArrayValue([]byte(v))
+		case time.Time:
+			pv = parquet.Int64Value(v.UnixMilli())
+		case bool:
+			pv = parquet.BooleanValue(v)
+		}
+		row[i] = pv.Level(0, 1, i)
+	}
+
+	_, err := w.pw.WriteRows([]parquet.Row{row})
+	if err != nil {
+		return err
+	}
+
+	w.rows++
+	if w.rows >= w.rowGroupSize {
+		return w.flush()
+	}
+
+	return nil
+}
+
+// check returns an error if the value is neither nil nor of the column's type.
+func (c Column) check(v interface{}) error {
+	if v == nil {
+		return nil
+	}
+
+	var ok bool
+	var expected string
+	switch c.Type {
+	case String:
+		_, ok = v.(string)
+		expected = "a string"
+	case Timestamp:
+		_, ok = v.(time.Time)
+		expected = "a time.Time"
+	case Bool:
+		_, ok = v.(bool)
+		expected = "a bool"
+	default:
+		return fmt.Errorf("parquet: column %s has an unknown type", c.Name)
+	}
+	if !ok {
+		return fmt.Errorf("parquet: column %s expects %s, got %T", c.Name, expected, v)
+	}
+
+	return nil
+}
+
+// flush writes the buffered rows as a row group. It does nothing when no rows are buffered.
+func (w *Writer) flush() error {
+	if w.rows == 0 {
+		return nil
+	}
+
+	err := w.pw.Flush()
+	if err != nil {
+		return err
+	}
+	w.rows = 0
+
+	return nil
+}
+
+// Close writes any buffered rows and the file footer. It does not close the underlying writer.
+func (w *Writer) Close() error {
+	return w.pw.Close()
+}
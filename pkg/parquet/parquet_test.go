@@ -0,0 +1,267 @@
+package parquet
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+var testColumns = []Column{
+	{Name: "name", Type: String, Doc: "Name of the row."},
+	{Name: "email", Type: String, Doc: "An email address."},
+	{Name: "seen_at", Type: Timestamp},
+	{Name: "enabled", Type: Bool},
+}
+
+// readFile opens the file with the parquet-go reader and returns the values of every row, row group by row group.
+func readFile(t *testing.T, data []byte) (*parquet.File, [][][]interface{}) {
+	t.Helper()
+
+	f, err := parquet.OpenFile(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("OpenFile() error = %v", err)
+	}
+
+	var ret [][][]interface{}
+	for _, rg := range f.RowGroups() {
+		rows := rg.Rows()
+		buf := make([]parquet.Row, rg.NumRows())
+		n, err := rows.ReadRows(buf)
+		if err != nil && err != io.EOF {
+			t.Fatalf("ReadRows() error = %v", err)
+		}
+		_ = rows.Close()
+
+		var values [][]interface{}
+		for _, row := range buf[:n] {
+			values = append(values, rowValues(t, row))
+		}
+		ret = append(ret, values)
+	}
+
+	return f, ret
+}
+
+func rowValues(t *testing.T, row parquet.Row) []interface{} {
+	t.Helper()
+
+	if len(row) != len(testColumns) {
+		t.Fatalf("row has %d values, want %d", len(row), len(testColumns))
+	}
+
+	ret := make([]interface{}, len(row))
+	for i, v := range row {
+		if v.Column() != i {
+			t.Fatalf("value %d is for column %d", i, v.Column())
+		}
+		if v.IsNull() {
+			continue
+		}
+
+		switch testColumns[i].Type {
+		case String:
+			ret[i] = string(v.ByteArray())
+		case Timestamp:
+			ret[i] = time.UnixMilli(v.Int64()).UTC()
+		case Bool:
+			ret[i] = v.Boolean()
+		}
+	}
+
+	return ret
+}
+
+func TestWriterRoundTrip(t *testing.T) {
+	ts := time.Date(2026, 1, 2, 3, 4, 5, 6_000_000, time.UTC)
+	rows := [][]interface{}{
+		{"alice", "alice@example.com", ts, true},
+		{nil, nil, nil, nil},
+		{"", "", time.Unix(0, 0).UTC(), false},
+		{"bob", nil, ts.Add(-time.Hour), nil},
+		{"carol ☃", "carol@example.com", nil, true},
+	}
+
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, testColumns)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.rowGroupSize = 2
+	w.SetMetadata("baton.table", "test")
+	for _, row := range rows {
+		err = w.Write(row...)
+		if err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	err = w.Close()
+	if err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	f, got := readFile(t, buf.Bytes())
+
+	var schema []string
+	for _, field := range f.Schema().Fields() {
+		schema = append(schema, fmt.Sprintf("%s optional=%t %s %s", field.Name(), field.Optional(), field.Type().Kind(), field.Type().LogicalType()))
+	}
+	wantSchema := []string{
+		"name optional=true BYTE_ARRAY STRING",
+		"email optional=true BYTE_ARRAY STRING",
+		"seen_at optional=true INT64 TIMESTAMP(isAdjustedToUTC=true,unit=MILLIS)",
+		"enabled optional=true BOOLEAN <nil>",
+	}
+	if !reflect.DeepEqual(schema, wantSchema) {
+		t.Errorf("schema = %q, want %q", schema, wantSchema)
+	}
+
+	want := [][][]interface{}{rows[0:2], rows[2:4], rows[4:5]}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("rows = %v, want %v", got, want)
+	}
+	if f.NumRows() != int64(len(rows)) {
+		t.Errorf("NumRows() = %d, want %d", f.NumRows(), len(rows))
+	}
+
+	for key, wantValue := range map[string]string{
+		"baton.table": "test",
+		"doc.name":    "Name of the row.",
+		"doc.email":   "An email address.",
+	} {
+		if v, ok := f.Lookup(key); !ok || v != wantValue {
+			t.Errorf("Lookup(%q) = %q, %t, want %q", key, v, ok, wantValue)
+		}
+	}
+	if _, ok := f.Lookup("doc.seen_at"); ok {
+		t.Error("columns without a doc should not have doc metadata")
+	}
+}
+
+func TestWriterFlush(t *testing.T) {
+	tests := []struct {
+		name         string
+		rows         int
+		rowGroupSize int
+		flushAfter   int
+		want         []int
+	}{
+		{name: "single row group", rows: 3, rowGroupSize: 10, want: []int{3}},
+		{name: "exact multiple of the row group size", rows: 4, rowGroupSize: 2, want: []int{2, 2}},
+		{name: "explicit flush", rows: 5, rowGroupSize: 10, flushAfter: 2, want: []int{2, 3}},
+		{name: "no rows", rows: 0, rowGroupSize: 10, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w, err := NewWriter(&buf, testColumns)
+			if err != nil {
+				t.Fatal(err)
+			}
+			w.rowGroupSize = tt.rowGroupSize
+
+			for i := 0; i < tt.rows; i++ {
+				err = w.Write(fmt.Sprintf("row %d", i), fmt.Sprintf("%d@example.com", i), nil, i%2 == 0)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if i+1 == tt.flushAfter {
+					err = w.flush()
+					if err != nil {
+						t.Fatal(err)
+					}
+					// Flushing again without buffered rows does not add an empty row group.
+					err = w.flush()
+					if err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+			err = w.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, groups := readFile(t, buf.Bytes())
+			var got []int
+			i := 0
+			for _, g := range groups {
+				got = append(got, len(g))
+				for _, row := range g {
+					if row[0] != fmt.Sprintf("row %d", i) || row[1] != fmt.Sprintf("%d@example.com", i) || row[2] != nil || row[3] != (i%2 == 0) {
+						t.Errorf("row %d = %v", i, row)
+					}
+					i++
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("row group sizes = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriterErrors(t *testing.T) {
+	_, err := NewWriter(io.Discard, nil)
+	if err == nil {
+		t.Error("NewWriter() without columns should fail")
+	}
+
+	_, err = NewWriter(io.Discard, []Column{{Name: "a,b", Type: String}})
+	if err == nil || !strings.Contains(err.Error(), "invalid column name") {
+		t.Errorf("NewWriter() error = %v, want an invalid column name", err)
+	}
+
+	_, err = NewWriter(io.Discard, []Column{{Name: "a", Type: Type(99)}})
+	if err == nil || !strings.Contains(err.Error(), "unknown type") {
+		t.Errorf("NewWriter() error = %v, want an unknown type", err)
+	}
+
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, testColumns)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		values  []interface{}
+		wantErr string
+	}{
+		{name: "too few values", values: []interface{}{"a"}, wantErr: "row has 1 values, expected 4"},
+		{name: "int for a string", values: []interface{}{1, nil, nil, nil}, wantErr: "column name expects a string, got int"},
+		{name: "int64 for a string", values: []interface{}{nil, int64(1), nil, nil}, wantErr: "column email expects a string, got int64"},
+		{name: "string for a timestamp", values: []interface{}{nil, nil, "2026-01-01", nil}, wantErr: "column seen_at expects a time.Time"},
+		{name: "bad value after good ones", values: []interface{}{"a", "b", time.Now(), "yes"}, wantErr: "column enabled expects a bool"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := w.Write(tt.values...)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Write() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	// Rejected rows are not partially written.
+	err = w.Write("ok", nil, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, groups := readFile(t, buf.Bytes())
+	want := [][][]interface{}{{{"ok", nil, nil, true}}}
+	if !reflect.DeepEqual(groups, want) {
+		t.Errorf("rows = %v, want %v", groups, want)
+	}
+}
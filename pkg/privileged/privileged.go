@@ -0,0 +1,147 @@
+package privileged
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	v2 "github.com/conductorone/baton-sdk/pb/c1/connector/v2"
+	"github.com/conductorone/baton-sdk/pkg/annotations"
+	"github.com/conductorone/baton/pkg/storecache"
+)
+
+// DefaultPatterns are matched against entitlement slugs and display names when no patterns are configured.
+var DefaultPatterns = []string{
+	"*admin*",
+	"*owner*",
+	"*root*",
+	"*superuser*",
+	"*super user*",
+	"*privileged*",
+}
+
+const (
+	ReasonAllowlist = "allowlist"
+	ReasonRole      = "role"
+	ReasonSlug      = "slug"
+	ReasonName      = "display name"
+)
+
+// Options configures how entitlements are classified.
+type Options struct {
+	// Patterns are case-insensitive glob patterns matched against entitlement slugs and display names.
+	Patterns []string
+	// AllowlistPath is a file listing entitlement IDs that are always privileged, one per line.
+	AllowlistPath string
+	// Roles marks entitlements on resources with the role trait as privileged.
+	Roles bool
+}
+
+// Classifier decides whether entitlements grant privileged access.
+type Classifier struct {
+	patterns  []string
+	allowlist map[string]struct{}
+	roles     bool
+}
+
+// New returns a classifier for the given options, reading the allowlist file if one is set.
+func New(opts Options) (*Classifier, error) {
+	c := &Classifier{
+		allowlist: make(map[string]struct{}),
+		roles:     opts.Roles,
+	}
+
+	for _, p := range opts.Patterns {
+		p = strings.ToLower(strings.TrimSpace(p))
+		if p == "" {
+			continue
+		}
+		if _, err := path.Match(p, ""); err != nil {
+			return nil, fmt.Errorf("invalid privileged pattern %q: %w", p, err)
+		}
+		c.patterns = append(c.patterns, p)
+	}
+
+	if opts.AllowlistPath != "" {
+		f, err := os.Open(opts.AllowlistPath)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			c.allowlist[line] = struct{}{}
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+func (c *Classifier) matchPattern(s string) (string, bool) {
+	s = strings.ToLower(s)
+	if s == "" {
+		return "", false
+	}
+
+	for _, p := range c.patterns {
+		if ok, _ := path.Match(p, s); ok {
+			return p, true
+		}
+	}
+
+	return "", false
+}
+
+// Classify reports whether the entitlement is privileged, along with the reason it was classified that way.
+func (c *Classifier) Classify(ctx context.Context, sc *storecache.StoreCache, en *v2.Entitlement) (bool, string, error) {
+	if _, ok := c.allowlist[en.Id]; ok {
+		return true, ReasonAllowlist, nil
+	}
+
+	if p, ok := c.matchPattern(en.Slug); ok {
+		return true, fmt.Sprintf("%s matches %s", ReasonSlug, p), nil
+	}
+
+	if p, ok := c.matchPattern(en.DisplayName); ok {
+		return true, fmt.Sprintf("%s matches %s", ReasonName, p), nil
+	}
+
+	if c.roles && en.GetResource().GetId() != nil {
+		rt, err := sc.GetResourceType(ctx, en.Resource.Id.ResourceType)
+		if err != nil {
+			return false, "", err
+		}
+		if slices.Contains(rt.Traits, v2.ResourceType_TRAIT_ROLE) {
+			return true, ReasonRole, nil
+		}
+
+		resource, err := sc.GetResource(ctx, en.Resource.Id)
+		if err != nil {
+			return false, "", err
+		}
+		annos := annotations.Annotations(resource.Annotations)
+		if annos.Contains(&v2.RoleTrait{}) {
+			return true, ReasonRole, nil
+		}
+	}
+
+	return false, "", nil
+}
+
+// IsPrivileged reports whether the entitlement is privileged.
+func (c *Classifier) IsPrivileged(ctx context.Context, sc *storecache.StoreCache, en *v2.Entitlement) (bool, error) {
+	ok, _, err := c.Classify(ctx, sc, en)
+	return ok, err
+}
@@ -0,0 +1,191 @@
+package rolemining
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Itemset is a set of items together with the transactions that contain every one of them.
+type Itemset struct {
+	Items []string
+	// Transactions are the indexes of the transactions containing the itemset, in ascending order.
+	Transactions []int
+}
+
+// Support returns the number of transactions containing the itemset.
+func (i *Itemset) Support() int {
+	return len(i.Transactions)
+}
+
+// Residual returns the items of a transaction that are not in the itemset, in transaction order.
+func (i *Itemset) Residual(transaction []string) []string {
+	in := make(map[string]struct{}, len(i.Items))
+	for _, item := range i.Items {
+		in[item] = struct{}{}
+	}
+
+	var ret []string
+	for _, item := range transaction {
+		if _, ok := in[item]; ok {
+			continue
+		}
+		in[item] = struct{}{}
+		ret = append(ret, item)
+	}
+
+	return ret
+}
+
+// MinSupport returns the number of transactions that make up percent of total, rounded up and at least one. The
+// percentage is applied before dividing so that whole percentages of whole counts are not rounded up by float error.
+func MinSupport(percent float64, total int) int {
+	n := int(math.Ceil(percent * float64(total) / 100))
+	if n < 1 {
+		return 1
+	}
+
+	return n
+}
+
+type node struct {
+	item string
+	tids []int
+}
+
+func intersect(a []int, b []int) []int {
+	var ret []int
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			ret = append(ret, a[i])
+			i++
+			j++
+		}
+	}
+
+	return ret
+}
+
+func tidsKey(tids []int) string {
+	var sb strings.Builder
+	for _, t := range tids {
+		sb.WriteString(strconv.Itoa(t))
+		sb.WriteByte(',')
+	}
+
+	return sb.String()
+}
+
+// ClosedItemsets returns the closed itemsets of the transactions: for every distinct set of transactions that at least
+// minSupport transactions share some items, the largest itemset they all contain. Itemsets smaller than minSize are
+// left out. maxSize bounds how far the search extends an itemset, so itemsets larger than maxSize are only found when
+// their extra items always occur together with the rest. A maxSize of zero does not bound the search.
+//
+// Itemsets are returned largest area first, where the area is the number of items times the support.
+func ClosedItemsets(transactions [][]string, minSupport int, minSize int, maxSize int) []*Itemset {
+	if minSupport < 1 {
+		minSupport = 1
+	}
+
+	tidsByItem := make(map[string][]int)
+	for t, items := range transactions {
+		seen := make(map[string]struct{}, len(items))
+		for _, item := range items {
+			if _, ok := seen[item]; ok {
+				continue
+			}
+			seen[item] = struct{}{}
+			tidsByItem[item] = append(tidsByItem[item], t)
+		}
+	}
+
+	var roots []node
+	for item, tids := range tidsByItem {
+		if len(tids) >= minSupport {
+			roots = append(roots, node{item: item, tids: tids})
+		}
+	}
+	// Visiting the most common items first lets rarer items be absorbed into the itemsets they always occur with.
+	sort.Slice(roots, func(i, j int) bool {
+		if len(roots[i].tids) != len(roots[j].tids) {
+			return len(roots[i].tids) > len(roots[j].tids)
+		}
+		return roots[i].item < roots[j].item
+	})
+
+	closed := make(map[string]*Itemset)
+	emit := func(items []string, tids []int) {
+		key := tidsKey(tids)
+		is, ok := closed[key]
+		if !ok {
+			is = &Itemset{Transactions: tids}
+			closed[key] = is
+		}
+		is.Items = append(is.Items, items...)
+	}
+
+	var search func(prefix []string, candidates []node)
+	search = func(prefix []string, candidates []node) {
+		for i, n := range candidates {
+			items := append(append([]string{}, prefix...), n.item)
+
+			var next []node
+			for _, m := range candidates[i+1:] {
+				tids := intersect(n.tids, m.tids)
+				if len(tids) < minSupport {
+					continue
+				}
+				if len(tids) == len(n.tids) {
+					// m occurs in every transaction n does, so it belongs to every itemset extending this one.
+					items = append(items, m.item)
+					continue
+				}
+				next = append(next, node{item: m.item, tids: tids})
+			}
+
+			emit(items, n.tids)
+			if maxSize == 0 || len(items) < maxSize {
+				search(items, next)
+			}
+		}
+	}
+	search(nil, roots)
+
+	var ret []*Itemset
+	for _, is := range closed {
+		seen := make(map[string]struct{})
+		var items []string
+		for _, item := range is.Items {
+			if _, ok := seen[item]; ok {
+				continue
+			}
+			seen[item] = struct{}{}
+			items = append(items, item)
+		}
+		if len(items) < minSize {
+			continue
+		}
+		sort.Strings(items)
+		is.Items = items
+		ret = append(ret, is)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		ai, aj := len(ret[i].Items)*ret[i].Support(), len(ret[j].Items)*ret[j].Support()
+		if ai != aj {
+			return ai > aj
+		}
+		if ret[i].Support() != ret[j].Support() {
+			return ret[i].Support() > ret[j].Support()
+		}
+		return strings.Join(ret[i].Items, "\x00") < strings.Join(ret[j].Items, "\x00")
+	})
+
+	return ret
+}
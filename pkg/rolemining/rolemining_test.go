@@ -0,0 +1,152 @@
+package rolemining
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// itemsetStrings formats itemsets as "<items>@<transactions>".
+func itemsetStrings(itemsets []*Itemset) []string {
+	var ret []string
+	for _, is := range itemsets {
+		ret = append(ret, fmt.Sprintf("%s@%v", strings.Join(is.Items, ","), is.Transactions))
+	}
+
+	return ret
+}
+
+func TestClosedItemsets(t *testing.T) {
+	transactions := [][]string{
+		{"a", "b", "c"},
+		{"a", "b", "c", "d"},
+		{"a", "b"},
+		{"a", "d"},
+		{"e"},
+	}
+
+	tests := []struct {
+		name         string
+		transactions [][]string
+		minSupport   int
+		minSize      int
+		maxSize      int
+		want         []string
+	}{
+		{
+			name:         "largest area first",
+			transactions: transactions,
+			minSupport:   2,
+			minSize:      1,
+			want:         []string{"a,b@[0 1 2]", "a,b,c@[0 1]", "a@[0 1 2 3]", "a,d@[1 3]"},
+		},
+		{
+			name:         "min size",
+			transactions: transactions,
+			minSupport:   2,
+			minSize:      2,
+			want:         []string{"a,b@[0 1 2]", "a,b,c@[0 1]", "a,d@[1 3]"},
+		},
+		{
+			name:         "min support",
+			transactions: transactions,
+			minSupport:   3,
+			minSize:      1,
+			want:         []string{"a,b@[0 1 2]", "a@[0 1 2 3]"},
+		},
+		{
+			name:         "min support below one",
+			transactions: [][]string{{"a"}, {"b"}},
+			minSupport:   0,
+			minSize:      1,
+			want:         []string{"a@[0]", "b@[1]"},
+		},
+		{
+			name:         "no itemset meets the support",
+			transactions: transactions,
+			minSupport:   5,
+			minSize:      1,
+			want:         nil,
+		},
+		{
+			name:         "max size stops extending",
+			transactions: transactions,
+			minSupport:   2,
+			minSize:      1,
+			maxSize:      1,
+			want:         []string{"a@[0 1 2 3]", "b@[0 1 2]", "c@[0 1]", "d@[1 3]"},
+		},
+		{
+			name:         "items that always occur together are kept past max size",
+			transactions: [][]string{{"x", "y"}, {"x", "y"}, {"x", "y", "z"}},
+			minSupport:   2,
+			minSize:      1,
+			maxSize:      1,
+			want:         []string{"x,y@[0 1 2]"},
+		},
+		{
+			name:         "duplicate items",
+			transactions: [][]string{{"a", "a", "b"}, {"b", "a"}},
+			minSupport:   2,
+			minSize:      1,
+			want:         []string{"a,b@[0 1]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := itemsetStrings(ClosedItemsets(tt.transactions, tt.minSupport, tt.minSize, tt.maxSize))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ClosedItemsets() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResidual(t *testing.T) {
+	tests := []struct {
+		name        string
+		items       []string
+		transaction []string
+		want        []string
+	}{
+		{name: "exceptions in transaction order", items: []string{"a", "c"}, transaction: []string{"d", "a", "b", "c"}, want: []string{"d", "b"}},
+		{name: "covered", items: []string{"a", "b"}, transaction: []string{"b", "a"}, want: nil},
+		{name: "duplicates", items: []string{"a"}, transaction: []string{"b", "a", "b"}, want: []string{"b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := &Itemset{Items: tt.items}
+			got := is.Residual(tt.transaction)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Residual() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMinSupport(t *testing.T) {
+	tests := []struct {
+		percent float64
+		total   int
+		want    int
+	}{
+		{percent: 10, total: 30, want: 3},
+		{percent: 7, total: 100, want: 7},
+		{percent: 10, total: 25, want: 3},
+		{percent: 12.5, total: 8, want: 1},
+		{percent: 100, total: 9, want: 9},
+		{percent: 0, total: 50, want: 1},
+		{percent: 10, total: 0, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%v%% of %d", tt.percent, tt.total), func(t *testing.T) {
+			if got := MinSupport(tt.percent, tt.total); got != tt.want {
+				t.Errorf("MinSupport() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
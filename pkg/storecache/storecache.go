@@ -20,6 +20,8 @@ type StoreCache struct {
 	resources     sync.Map
 	entitlements  sync.Map
 	grants        sync.Map
+	// missingResources holds the keys of resources that were not found in the store.
+	missingResources sync.Map
 }
 
 func (f *StoreCache) getMissingResource(id *v2.ResourceId) *v2.Resource {
@@ -109,6 +111,7 @@ func (f *StoreCache) GetResource(ctx context.Context, id *v2.ResourceId) (*v2.Re
 			zap.String("resource_id", id.Resource),
 		)
 		resource = f.getMissingResource(id)
+		f.missingResources.Store(cacheKey, struct{}{})
 	} else {
 		resource = resourceResp.Resource
 	}
@@ -118,6 +121,38 @@ func (f *StoreCache) GetResource(ctx context.Context, id *v2.ResourceId) (*v2.Re
 	return resource, nil
 }
 
+// ResourceExists reports whether the resource is in the store. Unlike GetResource, it does not log missing resources.
+func (f *StoreCache) ResourceExists(ctx context.Context, id *v2.ResourceId) (bool, error) {
+	if id == nil {
+		return false, fmt.Errorf("resource id must be set")
+	}
+
+	cacheKey := f.getResourceKey(id)
+	if _, ok := f.missingResources.Load(cacheKey); ok {
+		return false, nil
+	}
+	if _, ok := f.resources.Load(cacheKey); ok {
+		return true, nil
+	}
+
+	resourceResp, err := f.store.GetResource(ctx, &reader_v2.ResourcesReaderServiceGetResourceRequest{
+		ResourceId: id,
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return false, err
+	}
+
+	if resourceResp == nil || resourceResp.Resource == nil {
+		f.resources.Store(cacheKey, f.getMissingResource(id))
+		f.missingResources.Store(cacheKey, struct{}{})
+		return false, nil
+	}
+
+	f.resources.Store(cacheKey, resourceResp.Resource)
+
+	return true, nil
+}
+
 func (f *StoreCache) GetEntitlement(ctx context.Context, id string) (*v2.Entitlement, error) {
 	l := ctxzap.Extract(ctx)
 